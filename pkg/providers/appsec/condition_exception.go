@@ -0,0 +1,120 @@
+package appsec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// policyItemID holds the identifiers of a rule or an attack group within a security policy.
+type policyItemID struct {
+	configID int
+	version  int
+	policyID string
+	item     string
+}
+
+// String returns the resource ID of the item, config_id:version:security_policy_id:item
+func (id policyItemID) String() string {
+	return fmt.Sprintf("%d:%d:%s:%s", id.configID, id.version, id.policyID, id.item)
+}
+
+// getPolicyItemID returns the identifiers of the item stored in the itemKey argument, either from a resource ID of
+// the form config_id:version:security_policy_id:item or from the resource arguments.
+func getPolicyItemID(d *schema.ResourceData, itemKey string) (*policyItemID, error) {
+	if d.Id() != "" && strings.Contains(d.Id(), ":") {
+		s := strings.Split(d.Id(), ":")
+		if len(s) != 4 {
+			return nil, fmt.Errorf("invalid ID %q, expected config_id:version:security_policy_id:%s", d.Id(), itemKey)
+		}
+
+		configid, err := strconv.Atoi(s[0])
+		if err != nil {
+			return nil, err
+		}
+
+		version, err := strconv.Atoi(s[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if d.HasChange("version") {
+			version, err = tools.GetIntValue("version", d)
+			if err != nil && !errors.Is(err, tools.ErrNotFound) {
+				return nil, err
+			}
+		}
+
+		return &policyItemID{configID: configid, version: version, policyID: s[2], item: s[3]}, nil
+	}
+
+	configid, err := tools.GetIntValue("config_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+
+	version, err := tools.GetIntValue("version", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+
+	policyid, err := tools.GetStringValue("security_policy_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+
+	return &policyItemID{configID: configid, version: version, policyID: policyid, item: fmt.Sprint(d.Get(itemKey))}, nil
+}
+
+// conditionExceptionOps updates and removes the condition exception of a rule or an attack group.
+type conditionExceptionOps struct {
+	update func(ctx context.Context, payload json.RawMessage) error
+	remove func(ctx context.Context) error
+}
+
+// applyConditionException updates the condition exception when condition_exception is set, and removes it
+// when the argument has been cleared.
+func applyConditionException(ctx context.Context, d *schema.ResourceData, ops conditionExceptionOps) error {
+	conditionexception, err := tools.GetStringValue("condition_exception", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return err
+	}
+
+	if conditionexception != "" {
+		return ops.update(ctx, json.RawMessage(conditionexception))
+	}
+	if d.HasChange("condition_exception") {
+		return ops.remove(ctx)
+	}
+	return nil
+}
+
+// removeConditionException removes the condition exception on destroy, if the resource set one.
+func removeConditionException(ctx context.Context, d *schema.ResourceData, ops conditionExceptionOps) error {
+	if conditionexception, ok := d.GetOk("condition_exception"); ok && conditionexception.(string) != "" {
+		return ops.remove(ctx)
+	}
+	return nil
+}
+
+// setConditionException stores the condition exception returned by the API, or an empty string when none is set.
+func setConditionException(d *schema.ResourceData, isSet bool, conditionexception interface{}) error {
+	value := ""
+	if isSet {
+		jsonBody, err := json.Marshal(conditionexception)
+		if err != nil {
+			return err
+		}
+		value = string(jsonBody)
+	}
+	if err := d.Set("condition_exception", value); err != nil {
+		return fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	return nil
+}
@@ -1,8 +1,15 @@
 package appsec
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 // ValidateActions ensure actions are correct for API call
@@ -22,3 +29,103 @@ func ValidateActions(v interface{}, k string) (warnings []string, errors []error
 
 	return warnings, errors
 }
+
+// validateRuleIDCustomDiff fails the plan when rule_id is not part of the security policy's rule set.
+func validateRuleIDCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("APPSEC", "validateRuleIDCustomDiff")
+
+	if d.Id() != "" && !d.HasChange("rule_id") {
+		return nil
+	}
+	if !d.NewValueKnown("config_id") || !d.NewValueKnown("version") || !d.NewValueKnown("security_policy_id") || !d.NewValueKnown("rule_id") {
+		logger.Debugf("identifiers not known at plan time, skipping rule_id validation")
+		return nil
+	}
+
+	configid := d.Get("config_id").(int)
+	version := d.Get("version").(int)
+	policyid := d.Get("security_policy_id").(string)
+	ruleid := d.Get("rule_id").(int)
+
+	ruleactions := &appsec.GetRuleActionsResponse{}
+	key := fmt.Sprintf("ruleActions:%d:%d:%s", configid, version, policyid)
+	if err := meta.CacheGet(inst, key, ruleactions); err != nil {
+		if !akamai.IsNotFoundError(err) && !errors.Is(err, akamai.ErrCacheDisabled) {
+			return err
+		}
+		ruleactions, err = inst.Client(meta).GetRuleActions(ctx, appsec.GetRuleActionsRequest{
+			ConfigID: configid,
+			Version:  version,
+			PolicyID: policyid,
+		})
+		if err != nil {
+			logger.Errorf("calling 'getRuleActions': %s", err.Error())
+			return err
+		}
+		if err := meta.CacheSet(inst, key, ruleactions); err != nil && !errors.Is(err, akamai.ErrCacheDisabled) {
+			return err
+		}
+	}
+
+	ids := make([]int, 0, len(ruleactions.RuleActions))
+	for _, ra := range ruleactions.RuleActions {
+		if ra.ID == ruleid {
+			return nil
+		}
+		ids = append(ids, ra.ID)
+	}
+	sort.Ints(ids)
+
+	return fmt.Errorf("rule_id %d is not in the rule set of security policy %s; valid rule IDs are: %s", ruleid, policyid, strings.Trim(fmt.Sprint(ids), "[]"))
+}
+
+// validateAttackGroupCustomDiff fails the plan when attack_group is not part of the security policy's rule set.
+func validateAttackGroupCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("APPSEC", "validateAttackGroupCustomDiff")
+
+	if d.Id() != "" && !d.HasChange("attack_group") {
+		return nil
+	}
+	if !d.NewValueKnown("config_id") || !d.NewValueKnown("version") || !d.NewValueKnown("security_policy_id") || !d.NewValueKnown("attack_group") {
+		logger.Debugf("identifiers not known at plan time, skipping attack_group validation")
+		return nil
+	}
+
+	configid := d.Get("config_id").(int)
+	version := d.Get("version").(int)
+	policyid := d.Get("security_policy_id").(string)
+	attackgroup := d.Get("attack_group").(string)
+
+	attackgroupactions := &appsec.GetAttackGroupActionsResponse{}
+	key := fmt.Sprintf("attackGroupActions:%d:%d:%s", configid, version, policyid)
+	if err := meta.CacheGet(inst, key, attackgroupactions); err != nil {
+		if !akamai.IsNotFoundError(err) && !errors.Is(err, akamai.ErrCacheDisabled) {
+			return err
+		}
+		attackgroupactions, err = inst.Client(meta).GetAttackGroupActions(ctx, appsec.GetAttackGroupActionsRequest{
+			ConfigID: configid,
+			Version:  version,
+			PolicyID: policyid,
+		})
+		if err != nil {
+			logger.Errorf("calling 'getAttackGroupActions': %s", err.Error())
+			return err
+		}
+		if err := meta.CacheSet(inst, key, attackgroupactions); err != nil && !errors.Is(err, akamai.ErrCacheDisabled) {
+			return err
+		}
+	}
+
+	groups := make([]string, 0, len(attackgroupactions.AttackGroupActions))
+	for _, aga := range attackgroupactions.AttackGroupActions {
+		if aga.Group == attackgroup {
+			return nil
+		}
+		groups = append(groups, aga.Group)
+	}
+	sort.Strings(groups)
+
+	return fmt.Errorf("attack_group %q is not in the rule set of security policy %s; valid attack groups are: %s", attackgroup, policyid, strings.Join(groups, ", "))
+}
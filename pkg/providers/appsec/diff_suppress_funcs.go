@@ -81,6 +81,40 @@ func suppressEquivalentMatchTargetDiffs(k, old, new string, d *schema.ResourceDa
 	return compareMatchTargetsJSON(old, new)
 }
 
+func suppressEquivalentMatchTargetSequenceDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return compareMatchTargetSequenceJSON(old, new)
+}
+
+// compareMatchTargetSequenceJSON reports whether two match target sequences
+// assign the same sequence numbers to the same targets, regardless of the
+// order in which the targets are listed.
+func compareMatchTargetSequenceJSON(old, new string) bool {
+	var oldJSON, newJSON appsec.UpdateMatchTargetSequenceRequest
+	if old == new {
+		return true
+	}
+	if err := json.Unmarshal([]byte(old), &oldJSON); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newJSON); err != nil {
+		return false
+	}
+	if oldJSON.Type != newJSON.Type || len(oldJSON.TargetSequence) != len(newJSON.TargetSequence) {
+		return false
+	}
+
+	sequences := make(map[int]int, len(oldJSON.TargetSequence))
+	for _, ts := range oldJSON.TargetSequence {
+		sequences[ts.TargetID] = ts.Sequence
+	}
+	for _, ts := range newJSON.TargetSequence {
+		if seq, ok := sequences[ts.TargetID]; !ok || seq != ts.Sequence {
+			return false
+		}
+	}
+	return true
+}
+
 func suppressEquivalentJSONDiffsConditionException(k, old, new string, d *schema.ResourceData) bool {
 	return compareConditionExceptionJSON(old, new)
 
@@ -144,6 +178,13 @@ func compareMatchTargets(old, new *appsec.CreateMatchTargetResponse) bool {
 	sort.Strings(old.Hostnames)
 	sort.Strings(new.Hostnames)
 
+	sort.Slice(old.BypassNetworkLists, func(i, j int) bool {
+		return old.BypassNetworkLists[i].ID < old.BypassNetworkLists[j].ID
+	})
+	sort.Slice(new.BypassNetworkLists, func(i, j int) bool {
+		return new.BypassNetworkLists[i].ID < new.BypassNetworkLists[j].ID
+	})
+
 	new.TargetID = 0
 	old.TargetID = 0
 
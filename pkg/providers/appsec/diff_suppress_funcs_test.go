@@ -0,0 +1,47 @@
+package appsec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareMatchTargetSequenceJSON(t *testing.T) {
+	tests := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"same targets in different order": {
+			old:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":1},{"targetId":2,"sequence":2}]}`,
+			new:      `{"type":"website","targetSequence":[{"targetId":2,"sequence":2},{"targetId":1,"sequence":1}]}`,
+			expected: true,
+		},
+		"targets swapped sequence": {
+			old:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":1},{"targetId":2,"sequence":2}]}`,
+			new:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":2},{"targetId":2,"sequence":1}]}`,
+			expected: false,
+		},
+		"target added": {
+			old:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":1}]}`,
+			new:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":1},{"targetId":2,"sequence":2}]}`,
+			expected: false,
+		},
+		"different type": {
+			old:      `{"type":"website","targetSequence":[{"targetId":1,"sequence":1}]}`,
+			new:      `{"type":"api","targetSequence":[{"targetId":1,"sequence":1}]}`,
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, compareMatchTargetSequenceJSON(test.old, test.new))
+		})
+	}
+}
+
+func TestCompareMatchTargetsJSON(t *testing.T) {
+	old := `{"type":"website","hostnames":["a.example.com"],"bypassNetworkLists":[{"id":"1_A","name":"A"},{"id":"2_B","name":"B"}]}`
+	new := `{"type":"website","hostnames":["a.example.com"],"bypassNetworkLists":[{"id":"2_B","name":"B"},{"id":"1_A","name":"A"}]}`
+	assert.True(t, compareMatchTargetsJSON(old, new))
+}
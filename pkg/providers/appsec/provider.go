@@ -138,12 +138,14 @@ func Provider() *schema.Provider {
 			"akamai_appsec_reputation_protection":            resourceReputationProtection(),
 			"akamai_appsec_reputation_profile":               resourceReputationProfile(),
 			"akamai_appsec_reputation_profile_action":        resourceReputationProfileAction(),
+			"akamai_appsec_rule":                             resourceRule(),
 			"akamai_appsec_rule_upgrade":                     resourceRuleUpgrade(),
 			"akamai_appsec_security_policy":                  resourceSecurityPolicy(),
 			"akamai_appsec_security_policy_rename":           resourceSecurityPolicyRename(),
 			"akamai_appsec_siem_settings":                    resourceSiemSettings(),
 			"akamai_appsec_slow_post":                        resourceSlowPostProtectionSetting(),
 			"akamai_appsec_slowpost_protection":              resourceSlowPostProtection(),
+			"akamai_appsec_attack_group":                     resourceAttackGroup(),
 			"akamai_appsec_attack_group_action":              resourceAttackGroupAction(),
 			"akamai_appsec_version_notes":                    resourceVersionNotes(),
 			"akamai_appsec_waf_mode":                         resourceWAFMode(),
@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // appsec v1
@@ -24,6 +25,12 @@ func resourceActivations() *schema.Resource {
 		ReadContext:   resourceActivationsRead,
 		DeleteContext: resourceActivationsDelete,
 		UpdateContext: resourceActivationsUpdate,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  &ActivationResourceTimeout,
+			Update:  &ActivationResourceTimeout,
+			Delete:  &ActivationResourceTimeout,
+			Default: &ActivationResourceTimeout,
+		},
 		Schema: map[string]*schema.Schema{
 			"config_id": {
 				Type:     schema.TypeInt,
@@ -34,10 +41,10 @@ func resourceActivations() *schema.Resource {
 				Required: true,
 			},
 			"network": {
-				Type:     schema.TypeString,
-				Optional: true,
-
-				Default: "STAGING",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "STAGING",
+				ValidateFunc: validation.StringInSlice([]string{"STAGING", "PRODUCTION"}, false),
 			},
 			"notes": {
 				Type:     schema.TypeString,
@@ -57,10 +64,20 @@ func resourceActivations() *schema.Resource {
 
 				Elem: &schema.Schema{Type: schema.TypeString},
 			},
+			"on_destroy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      OnDestroyDeactivate,
+				ValidateFunc: validation.StringInSlice([]string{OnDestroyDeactivate, OnDestroyRollback, OnDestroyNone}, false),
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"previous_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -68,11 +85,20 @@ func resourceActivations() *schema.Resource {
 const (
 	// ActivationPollMinimum is the minumum polling interval for activation creation
 	ActivationPollMinimum = time.Minute
+	// OnDestroyDeactivate deactivates the configuration version on destroy
+	OnDestroyDeactivate = "DEACTIVATE"
+	// OnDestroyRollback re-activates the previously active configuration version on destroy
+	OnDestroyRollback = "ROLLBACK"
+	// OnDestroyNone leaves the configuration version active on destroy
+	OnDestroyNone = "NONE"
 )
 
 var (
 	// ActivationPollInterval is the interval for polling an activation status on creation
 	ActivationPollInterval = ActivationPollMinimum
+
+	// ActivationResourceTimeout is the default timeout for activation operations
+	ActivationResourceTimeout = time.Minute * 90
 )
 
 func resourceActivationsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -90,62 +116,18 @@ func resourceActivationsCreate(ctx context.Context, d *schema.ResourceData, m in
 		return nil
 	}
 
-	createActivations := appsec.CreateActivationsRequest{}
-
-	createActivationsreq := appsec.GetActivationsRequest{}
-
-	ap := appsec.ActivationConfigs{}
-
-	configid, err := tools.GetIntValue("config_id", d)
-	if err != nil && !errors.Is(err, tools.ErrNotFound) {
-		return diag.FromErr(err)
-	}
-	ap.ConfigID = configid
-
 	version, err := tools.GetIntValue("version", d)
 	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
-	ap.ConfigVersion = version
 
-	network, err := tools.GetStringValue("network", d)
-	if err != nil && !errors.Is(err, tools.ErrNotFound) {
-		return diag.FromErr(err)
-	}
-	createActivations.Network = network
-
-	createActivations.Action = "ACTIVATE"
-	createActivations.ActivationConfigs = append(createActivations.ActivationConfigs, ap)
-	createActivations.NotificationEmails = tools.SetToStringSlice(d.Get("notification_emails").(*schema.Set))
-
-	postresp, err := client.CreateActivations(ctx, createActivations, true)
+	activation, err := activateConfigVersion(ctx, client, d, version)
 	if err != nil {
 		logger.Errorf("calling 'createActivations': %s", err.Error())
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.Itoa(postresp.ActivationID))
-
-	if err := d.Set("status", postresp.Status); err != nil {
-		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
-	}
-
-	createActivationsreq.ActivationID = postresp.ActivationID
-	activation, err := lookupActivation(ctx, client, createActivationsreq)
-	for activation.Status != appsec.StatusActive {
-		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
-			act, err := client.GetActivations(ctx, createActivationsreq)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			activation = act
-
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("activation context terminated: %w", ctx.Err()))
-		}
-	}
+	d.SetId(strconv.Itoa(activation.ActivationID))
 
 	return resourceActivationsRead(ctx, d, m)
 }
@@ -165,65 +147,22 @@ func resourceActivationsUpdate(ctx context.Context, d *schema.ResourceData, m in
 		return nil
 	}
 
-	createActivations := appsec.CreateActivationsRequest{}
-
-	getActivationsreq := appsec.GetActivationsRequest{}
-
-	ap := appsec.ActivationConfigs{}
-
-	configID, err := tools.GetIntValue("config_id", d)
-	if err != nil && !errors.Is(err, tools.ErrNotFound) {
-		return diag.FromErr(err)
+	if !d.HasChanges("config_id", "version", "network", "activate") {
+		return resourceActivationsRead(ctx, d, m)
 	}
-	ap.ConfigID = configID
 
 	version, err := tools.GetIntValue("version", d)
 	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
-	ap.ConfigVersion = version
-
-	network, err := tools.GetStringValue("network", d)
-	if err != nil && !errors.Is(err, tools.ErrNotFound) {
-		return diag.FromErr(err)
-	}
-	createActivations.Network = network
-
-	createActivations.Action = "ACTIVATE"
-	createActivations.ActivationConfigs = append(createActivations.ActivationConfigs, ap)
-	createActivations.NotificationEmails = tools.SetToStringSlice(d.Get("notification_emails").(*schema.Set))
 
-	activations, err := client.CreateActivations(ctx, createActivations, true)
+	activation, err := activateConfigVersion(ctx, client, d, version)
 	if err != nil {
 		logger.Errorf("calling 'createActivations': %s", err.Error())
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.Itoa(activations.ActivationID))
-
-	if err := d.Set("status", activations.Status); err != nil {
-		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
-	}
-
-	getActivationsreq.ActivationID = activations.ActivationID
-	activation, err := lookupActivation(ctx, client, getActivationsreq)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	for activation.Status != appsec.StatusActive {
-		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
-			act, err := client.GetActivations(ctx, getActivationsreq)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			activation = act
-
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("activation context terminated: %w", ctx.Err()))
-		}
-	}
+	d.SetId(strconv.Itoa(activation.ActivationID))
 
 	return resourceActivationsRead(ctx, d, m)
 }
@@ -233,29 +172,49 @@ func resourceActivationsDelete(ctx context.Context, d *schema.ResourceData, m in
 	client := inst.Client(meta)
 	logger := meta.Log("APPSEC", "resourceActivationsRemove")
 
+	if d.Id() == "" || d.Id() == "none" {
+		return nil
+	}
+
 	activate, err := tools.GetBoolValue("activate", d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if !activate {
-		d.SetId("none")
-		logger.Debugf("Done")
-		return nil
-	}
 
-	removeActivations := appsec.RemoveActivationsRequest{}
-	createActivationsreq := appsec.GetActivationsRequest{}
+	ondestroy, err := tools.GetStringValue("on_destroy", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
 
-	if d.Id() == "" || d.Id() == "none" {
+	if !activate || ondestroy == OnDestroyNone {
+		logger.Debugf("leaving configuration version active on destroy")
+		d.SetId("")
 		return nil
 	}
 
-	ActivationID, errconv := strconv.Atoi(d.Id())
+	if ondestroy == OnDestroyRollback {
+		previousversion, err := tools.GetIntValue("previous_version", d)
+		if err != nil && !errors.Is(err, tools.ErrNotFound) {
+			return diag.FromErr(err)
+		}
+		if previousversion > 0 {
+			if _, err := activateConfigVersion(ctx, client, d, previousversion); err != nil {
+				logger.Errorf("calling 'createActivations': %s", err.Error())
+				return diag.FromErr(err)
+			}
+			d.SetId("")
+			return nil
+		}
+		logger.Warnf("no previous version to roll back to, deactivating instead")
+	}
 
-	if errconv != nil {
+	activationID, err := strconv.Atoi(d.Id())
+	if err != nil {
 		return diag.FromErr(err)
 	}
-	removeActivations.ActivationID = ActivationID
+
+	removeActivations := appsec.RemoveActivationsRequest{}
+	removeActivations.ActivationID = activationID
 
 	ap := appsec.ActivationConfigs{}
 
@@ -277,6 +236,12 @@ func resourceActivationsDelete(ctx context.Context, d *schema.ResourceData, m in
 	}
 	removeActivations.Network = network
 
+	notes, err := tools.GetStringValue("notes", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	removeActivations.Note = notes
+
 	removeActivations.NotificationEmails = tools.SetToStringSlice(d.Get("notification_emails").(*schema.Set))
 
 	removeActivations.Action = "DEACTIVATE"
@@ -284,38 +249,13 @@ func resourceActivationsDelete(ctx context.Context, d *schema.ResourceData, m in
 	removeActivations.ActivationConfigs = append(removeActivations.ActivationConfigs, ap)
 
 	postresp, err := client.RemoveActivations(ctx, removeActivations)
-
 	if err != nil {
 		logger.Errorf("calling 'removeActivations': %s", err.Error())
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.Itoa(postresp.ActivationID))
-
-	if err := d.Set("status", postresp.Status); err != nil {
-		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
-	}
-
-	createActivationsreq.ActivationID = postresp.ActivationID
-
-	activation, err := lookupActivation(ctx, client, createActivationsreq)
-	for activation.Status != appsec.StatusDeactivated {
-		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
-			act, err := client.GetActivations(ctx, createActivationsreq)
-
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			activation = act
-
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("activation context terminated: %w", ctx.Err()))
-		}
-	}
-
-	if err := d.Set("status", activation.Status); err != nil {
-		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	if _, err := waitForActivation(ctx, client, postresp.ActivationID, appsec.StatusDeactivated); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -350,11 +290,88 @@ func resourceActivationsRead(ctx context.Context, d *schema.ResourceData, m inte
 	if err := d.Set("status", activations.Status); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
+
+	previousversion := 0
+	if len(activations.ActivationConfigs) > 0 {
+		previousversion = activations.ActivationConfigs[0].PreviousConfigVersion
+	}
+	if err := d.Set("previous_version", previousversion); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
 	d.SetId(strconv.Itoa(activations.ActivationID))
 
 	return nil
 }
 
+// activateConfigVersion activates the given version of the configuration on the resource's network
+// and waits for the activation to complete.
+func activateConfigVersion(ctx context.Context, client appsec.APPSEC, d *schema.ResourceData, version int) (*appsec.GetActivationsResponse, error) {
+	createActivations := appsec.CreateActivationsRequest{}
+
+	ap := appsec.ActivationConfigs{}
+
+	configid, err := tools.GetIntValue("config_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	ap.ConfigID = configid
+	ap.ConfigVersion = version
+
+	network, err := tools.GetStringValue("network", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	createActivations.Network = network
+
+	notes, err := tools.GetStringValue("notes", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return nil, err
+	}
+	createActivations.Note = notes
+
+	createActivations.Action = "ACTIVATE"
+	createActivations.ActivationConfigs = append(createActivations.ActivationConfigs, ap)
+	createActivations.NotificationEmails = tools.SetToStringSlice(d.Get("notification_emails").(*schema.Set))
+
+	postresp, err := client.CreateActivations(ctx, createActivations, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return waitForActivation(ctx, client, postresp.ActivationID, appsec.StatusActive)
+}
+
+// waitForActivation polls the activation until it reaches the given status, fails, or the context
+// (bounded by the resource timeout) is done.
+func waitForActivation(ctx context.Context, client appsec.APPSEC, activationID int, status appsec.StatusValue) (*appsec.GetActivationsResponse, error) {
+	query := appsec.GetActivationsRequest{ActivationID: activationID}
+
+	activation, err := lookupActivation(ctx, client, query)
+	if err != nil {
+		return nil, err
+	}
+	for activation.Status != status {
+		if activation.Status == appsec.StatusFailed || activation.Status == appsec.StatusAborted {
+			return nil, fmt.Errorf("activation %d ended with status %s", activationID, activation.Status)
+		}
+
+		select {
+		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+			act, err := client.GetActivations(ctx, query)
+			if err != nil {
+				return nil, err
+			}
+			activation = act
+
+		case <-ctx.Done():
+			return nil, fmt.Errorf("activation context terminated: %w", ctx.Err())
+		}
+	}
+
+	return activation, nil
+}
+
 func lookupActivation(ctx context.Context, client appsec.APPSEC, query appsec.GetActivationsRequest) (*appsec.GetActivationsResponse, error) {
 	activations, err := client.GetActivations(ctx, query)
 	if err != nil {
@@ -362,6 +379,4 @@ func lookupActivation(ctx context.Context, client appsec.APPSEC, query appsec.Ge
 	}
 
 	return activations, nil
-
-	return nil, nil
 }
@@ -2,6 +2,7 @@ package appsec
 
 import (
 	"encoding/json"
+	"regexp"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
@@ -32,7 +33,7 @@ func TestAccAkamaiActivations_res_basic(t *testing.T) {
 
 		client.On("CreateActivations",
 			mock.Anything, // ctx is irrelevant for this test
-			appsec.CreateActivationsRequest{Action: "ACTIVATE", Network: "STAGING", Note: "", NotificationEmails: []string{"martin@email.io"}, ActivationConfigs: []struct {
+			appsec.CreateActivationsRequest{Action: "ACTIVATE", Network: "STAGING", Note: "TEST Notes", NotificationEmails: []string{"martin@email.io"}, ActivationConfigs: []struct {
 				ConfigID      int "json:\"configId\""
 				ConfigVersion int "json:\"configVersion\""
 			}{struct {
@@ -43,7 +44,7 @@ func TestAccAkamaiActivations_res_basic(t *testing.T) {
 
 		client.On("RemoveActivations",
 			mock.Anything, // ctx is irrelevant for this test
-			appsec.RemoveActivationsRequest{ActivationID: 547694, Action: "DEACTIVATE", Network: "STAGING", Note: "", NotificationEmails: []string{"martin@email.io"}, ActivationConfigs: []struct {
+			appsec.RemoveActivationsRequest{ActivationID: 547694, Action: "DEACTIVATE", Network: "STAGING", Note: "TEST Notes", NotificationEmails: []string{"martin@email.io"}, ActivationConfigs: []struct {
 				ConfigID      int "json:\"configId\""
 				ConfigVersion int "json:\"configVersion\""
 			}{struct {
@@ -70,4 +71,209 @@ func TestAccAkamaiActivations_res_basic(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("rollback to previous version on destroy", func(t *testing.T) {
+		client := &mockappsec{}
+
+		ga := appsec.GetActivationsResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResActivations/ActivationsRollback.json"))
+		json.Unmarshal([]byte(expectJSR), &ga)
+
+		cr := appsec.CreateActivationsResponse{}
+		json.Unmarshal([]byte(expectJSR), &cr)
+
+		gp := appsec.GetActivationsResponse{}
+		expectJSP := compactJSON(loadFixtureBytes("testdata/TestResActivations/ActivationsPreviousVersion.json"))
+		json.Unmarshal([]byte(expectJSP), &gp)
+
+		cp := appsec.CreateActivationsResponse{}
+		json.Unmarshal([]byte(expectJSP), &cp)
+
+		client.On("GetActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetActivationsRequest{ActivationID: 547694},
+		).Return(&ga, nil)
+
+		client.On("CreateActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			activationsRequest(7),
+		).Return(&cr, nil).Once()
+
+		client.On("GetActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetActivationsRequest{ActivationID: 547695},
+		).Return(&gp, nil)
+
+		client.On("CreateActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			activationsRequest(6),
+		).Return(&cp, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResActivations/on_destroy_rollback.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "id", "547694"),
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "status", "ACTIVATED"),
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "previous_version", "6"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "RemoveActivations", mock.Anything, mock.Anything)
+	})
+
+	t.Run("rollback without previous version deactivates on destroy", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cu := appsec.RemoveActivationsResponse{}
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResActivations/ActivationsDelete.json"))
+		json.Unmarshal([]byte(expectJSU), &cu)
+
+		ga := appsec.GetActivationsResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResActivations/Activations.json"))
+		json.Unmarshal([]byte(expectJSR), &ga)
+
+		cr := appsec.CreateActivationsResponse{}
+		json.Unmarshal([]byte(expectJSR), &cr)
+
+		client.On("GetActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetActivationsRequest{ActivationID: 547694},
+		).Return(&ga, nil)
+
+		client.On("CreateActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			activationsRequest(7),
+		).Return(&cr, nil).Once()
+
+		removeActivations := appsec.RemoveActivationsRequest{
+			ActivationID:       547694,
+			Action:             "DEACTIVATE",
+			Network:            "STAGING",
+			Note:               "TEST Notes",
+			NotificationEmails: []string{"martin@email.io"},
+			ActivationConfigs:  activationsRequest(7).ActivationConfigs,
+		}
+
+		client.On("RemoveActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			removeActivations,
+		).Return(&cu, nil).Run(func(args mock.Arguments) {
+			ga.Status = appsec.StatusDeactivated
+		})
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResActivations/on_destroy_rollback.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "id", "547694"),
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "previous_version", "0"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("leave version active on destroy", func(t *testing.T) {
+		client := &mockappsec{}
+
+		ga := appsec.GetActivationsResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResActivations/Activations.json"))
+		json.Unmarshal([]byte(expectJSR), &ga)
+
+		cr := appsec.CreateActivationsResponse{}
+		json.Unmarshal([]byte(expectJSR), &cr)
+
+		client.On("GetActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetActivationsRequest{ActivationID: 547694},
+		).Return(&ga, nil)
+
+		client.On("CreateActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			activationsRequest(7),
+		).Return(&cr, nil).Once()
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResActivations/on_destroy_none.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "id", "547694"),
+							resource.TestCheckResourceAttr("akamai_appsec_activations.test", "on_destroy", "NONE"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "RemoveActivations", mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed activation", func(t *testing.T) {
+		client := &mockappsec{}
+
+		ga := appsec.GetActivationsResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResActivations/ActivationsFailed.json"))
+		json.Unmarshal([]byte(expectJSR), &ga)
+
+		cr := appsec.CreateActivationsResponse{}
+		json.Unmarshal([]byte(expectJSR), &cr)
+
+		client.On("GetActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetActivationsRequest{ActivationID: 547696},
+		).Return(&ga, nil)
+
+		client.On("CreateActivations",
+			mock.Anything, // ctx is irrelevant for this test
+			activationsRequest(7),
+		).Return(&cr, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResActivations/match_by_id.tf"),
+						ExpectError: regexp.MustCompile("activation 547696 ended with status FAILED"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+}
+
+// activationsRequest returns the request sent for the test configurations to activate version of
+// configuration 43253 on staging
+func activationsRequest(version int) appsec.CreateActivationsRequest {
+	req := appsec.CreateActivationsRequest{
+		Action:             "ACTIVATE",
+		Network:            "STAGING",
+		Note:               "TEST Notes",
+		NotificationEmails: []string{"martin@email.io"},
+	}
+	req.ActivationConfigs = append(req.ActivationConfigs, struct {
+		ConfigID      int "json:\"configId\""
+		ConfigVersion int "json:\"configVersion\""
+	}{ConfigID: 43253, ConfigVersion: version})
+	return req
 }
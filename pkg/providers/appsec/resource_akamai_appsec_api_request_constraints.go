@@ -31,6 +31,7 @@ func resourceApiRequestConstraints() *schema.Resource {
 			"config_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
@@ -39,6 +40,7 @@ func resourceApiRequestConstraints() *schema.Resource {
 			"security_policy_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"action": {
 				Type:         schema.TypeString,
@@ -48,6 +50,7 @@ func resourceApiRequestConstraints() *schema.Resource {
 			"api_endpoint_id": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -149,6 +152,16 @@ func resourceApiRequestConstraintsRead(ctx context.Context, d *schema.ResourceDa
 				}
 			}
 		}
+	} else if len(response.APIEndpoints) > 0 {
+		// When managing all endpoints, the action is only known if every endpoint shares it; otherwise
+		// store a placeholder so that the plan shows a change and the next apply brings them back in line.
+		action, ok := commonApiEndpointAction(response.APIEndpoints)
+		if !ok {
+			action = MixedApiEndpointActions
+		}
+		if err := d.Set("action", action); err != nil {
+			return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+		}
 	}
 	if getApiRequestConstraints.ApiID != 0 {
 		d.SetId(fmt.Sprintf("%d:%d:%s:%d", getApiRequestConstraints.ConfigID, getApiRequestConstraints.Version, getApiRequestConstraints.PolicyID, getApiRequestConstraints.ApiID))
@@ -350,3 +363,22 @@ func resourceApiRequestConstraintsUpdate(ctx context.Context, d *schema.Resource
 
 	return resourceApiRequestConstraintsRead(ctx, d, m)
 }
+
+// MixedApiEndpointActions is the action read back when the API endpoints of a policy don't share the same action
+const MixedApiEndpointActions = "mixed"
+
+// commonApiEndpointAction returns the action shared by all the given API endpoints, if any.
+func commonApiEndpointAction(endpoints []appsec.ApiEndpoint) (string, bool) {
+	if len(endpoints) == 0 {
+		return "", false
+	}
+
+	action := endpoints[0].Action
+	for _, endpoint := range endpoints[1:] {
+		if endpoint.Action != action {
+			return "", false
+		}
+	}
+
+	return action, true
+}
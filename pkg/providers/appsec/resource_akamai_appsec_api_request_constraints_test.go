@@ -6,6 +6,7 @@ import (
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -67,4 +68,155 @@ func TestAccAkamaiApiRequestConstraints_res_basic(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("all API endpoints sharing an action", func(t *testing.T) {
+		client := &mockappsec{}
+
+		// Every endpoint uses alert, so the action is read back
+		cr := appsec.GetApiRequestConstraintsResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResApiRequestConstraints/ApiRequestConstraintsShared.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		cu := appsec.UpdateApiRequestConstraintsResponse{}
+		json.Unmarshal([]byte(expectJS), &cu)
+
+		crp := appsec.GetPolicyProtectionsResponse{}
+		expectJSP := compactJSON(loadFixtureBytes("testdata/TestDSPolicyProtections/PolicyProtections.json"))
+		json.Unmarshal([]byte(expectJSP), &crp)
+
+		client.On("GetApiRequestConstraints",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetApiRequestConstraintsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&cr, nil)
+
+		client.On("UpdateApiRequestConstraints",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateApiRequestConstraintsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Action: "alert"},
+		).Return(&cu, nil)
+
+		client.On("GetPolicyProtections",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetPolicyProtectionsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&crp, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResApiRequestConstraints/all_endpoints.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "id", "43253:7:AAAA_81230"),
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "api_endpoint_id", "0"),
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "action", "alert"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("all API endpoints with mixed actions", func(t *testing.T) {
+		client := &mockappsec{}
+
+		// Endpoints use alert and deny until the configured action is applied to all of them
+		mixed := appsec.GetApiRequestConstraintsResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResApiRequestConstraints/ApiRequestConstraintsMixed.json"))
+		json.Unmarshal([]byte(expectJS), &mixed)
+
+		cr := appsec.GetApiRequestConstraintsResponse{}
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		cu := appsec.UpdateApiRequestConstraintsResponse{}
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResApiRequestConstraints/ApiRequestConstraintsShared.json"))
+		json.Unmarshal([]byte(expectJSU), &cu)
+
+		crp := appsec.GetPolicyProtectionsResponse{}
+		expectJSP := compactJSON(loadFixtureBytes("testdata/TestDSPolicyProtections/PolicyProtections.json"))
+		json.Unmarshal([]byte(expectJSP), &crp)
+
+		client.On("GetApiRequestConstraints",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetApiRequestConstraintsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&cr, nil)
+
+		client.On("UpdateApiRequestConstraints",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateApiRequestConstraintsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Action: "alert"},
+		).Return(&cu, nil).Times(2).Run(func(args mock.Arguments) {
+			// the configured action now applies to every endpoint
+			cr = appsec.GetApiRequestConstraintsResponse{}
+			json.Unmarshal([]byte(expectJSU), &cr)
+		})
+
+		client.On("GetPolicyProtections",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetPolicyProtectionsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&crp, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResApiRequestConstraints/all_endpoints.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "id", "43253:7:AAAA_81230"),
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "api_endpoint_id", "0"),
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "action", "alert"),
+						),
+					},
+					{
+						// an endpoint action changed outside of Terraform
+						PreConfig: func() {
+							cr = mixed
+						},
+						Config:             loadFixtureString("testdata/TestResApiRequestConstraints/all_endpoints.tf"),
+						PlanOnly:           true,
+						ExpectNonEmptyPlan: true,
+					},
+					{
+						Config: loadFixtureString("testdata/TestResApiRequestConstraints/all_endpoints.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_api_request_constraints.test", "action", "alert"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+}
+
+func TestCommonApiEndpointAction(t *testing.T) {
+	tests := map[string]struct {
+		endpoints []appsec.ApiEndpoint
+		action    string
+		ok        bool
+	}{
+		"no endpoints": {
+			endpoints: nil,
+		},
+		"shared action": {
+			endpoints: []appsec.ApiEndpoint{{ID: 1, Action: "alert"}, {ID: 2, Action: "alert"}},
+			action:    "alert",
+			ok:        true,
+		},
+		"mixed actions": {
+			endpoints: []appsec.ApiEndpoint{{ID: 1, Action: "alert"}, {ID: 2, Action: "deny"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			action, ok := commonApiEndpointAction(test.endpoints)
+			assert.Equal(t, test.action, action)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
 }
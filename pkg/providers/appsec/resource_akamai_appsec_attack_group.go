@@ -0,0 +1,224 @@
+package appsec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// appsec v1
+//
+// https://developer.akamai.com/api/cloud_security/application_security/v1.html
+func resourceAttackGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAttackGroupUpdate,
+		ReadContext:   resourceAttackGroupRead,
+		UpdateContext: resourceAttackGroupUpdate,
+		DeleteContext: resourceAttackGroupDelete,
+		CustomizeDiff: validateAttackGroupCustomDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"config_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"security_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attack_group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"attack_group_action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateActions,
+			},
+			"condition_exception": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJsonDiffsGeneric,
+			},
+		},
+	}
+}
+
+// attackGroupConditionExceptionOps returns the condition exception operations of the attack group.
+func attackGroupConditionExceptionOps(client appsec.APPSEC, logger log.Interface, id *policyItemID) conditionExceptionOps {
+	return conditionExceptionOps{
+		update: func(ctx context.Context, payload json.RawMessage) error {
+			_, err := client.UpdateAttackGroupConditionException(ctx, appsec.UpdateAttackGroupConditionExceptionRequest{
+				ConfigID:       id.configID,
+				Version:        id.version,
+				PolicyID:       id.policyID,
+				Group:          id.item,
+				JsonPayloadRaw: payload,
+			})
+			if err != nil {
+				logger.Errorf("calling 'updateAttackGroupConditionException': %s", err.Error())
+			}
+			return err
+		},
+		remove: func(ctx context.Context) error {
+			_, err := client.RemoveAttackGroupConditionException(ctx, appsec.RemoveAttackGroupConditionExceptionRequest{
+				ConfigID: id.configID,
+				Version:  id.version,
+				PolicyID: id.policyID,
+				Group:    id.item,
+			})
+			if err != nil {
+				logger.Errorf("calling 'removeAttackGroupConditionException': %s", err.Error())
+			}
+			return err
+		},
+	}
+}
+
+func resourceAttackGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceAttackGroupRead")
+
+	id, err := getPolicyItemID(d, "attack_group")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attackgroupaction, err := client.GetAttackGroupAction(ctx, appsec.GetAttackGroupActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		Group:    id.item,
+	})
+	if err != nil {
+		logger.Errorf("calling 'getAttackGroupAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	attackgroupconditionexception, err := client.GetAttackGroupConditionException(ctx, appsec.GetAttackGroupConditionExceptionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		Group:    id.item,
+	})
+	if err != nil {
+		logger.Errorf("calling 'getAttackGroupConditionException': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("config_id", id.configID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("version", id.version); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("security_policy_id", id.policyID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("attack_group", id.item); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("attack_group_action", attackgroupaction.Action); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	isSet := attackgroupconditionexception.AdvancedExceptionsList != nil || attackgroupconditionexception.Exception != nil
+	if err := setConditionException(d, isSet, attackgroupconditionexception); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+
+	return nil
+}
+
+func resourceAttackGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceAttackGroupRemove")
+
+	id, err := getPolicyItemID(d, "attack_group")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := removeConditionException(ctx, d, attackGroupConditionExceptionOps(client, logger, id)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateAttackGroupAction(ctx, appsec.UpdateAttackGroupActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		Group:    id.item,
+		Action:   "none",
+	})
+	if err != nil {
+		logger.Errorf("calling 'removeAttackGroupAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAttackGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceAttackGroupUpdate")
+
+	id, err := getPolicyItemID(d, "attack_group")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attackgroupaction, err := tools.GetStringValue("attack_group_action", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateAttackGroupAction(ctx, appsec.UpdateAttackGroupActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		Group:    id.item,
+		Action:   attackgroupaction,
+	})
+	if err != nil {
+		logger.Errorf("calling 'updateAttackGroupAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	if err := applyConditionException(ctx, d, attackGroupConditionExceptionOps(client, logger, id)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+
+	return resourceAttackGroupRead(ctx, d, m)
+}
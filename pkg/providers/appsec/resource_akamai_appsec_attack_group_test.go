@@ -0,0 +1,99 @@
+package appsec
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccAkamaiAttackGroup_res_basic(t *testing.T) {
+	t.Run("match by AttackGroup ID", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cu := appsec.UpdateAttackGroupActionResponse{}
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResAttackGroup/AttackGroupAction.json"))
+		json.Unmarshal([]byte(expectJSU), &cu)
+
+		cr := appsec.GetAttackGroupActionResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResAttackGroup/AttackGroupAction.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		ceu := appsec.UpdateAttackGroupConditionExceptionResponse{}
+		expectJSCEU := compactJSON(loadFixtureBytes("testdata/TestResAttackGroup/AttackGroupConditionException.json"))
+		json.Unmarshal([]byte(expectJSCEU), &ceu)
+
+		cer := appsec.GetAttackGroupConditionExceptionResponse{}
+		expectJSCE := compactJSON(loadFixtureBytes("testdata/TestResAttackGroup/AttackGroupConditionException.json"))
+		json.Unmarshal([]byte(expectJSCE), &cer)
+
+		ced := appsec.RemoveAttackGroupConditionExceptionResponse{}
+
+		agas := appsec.GetAttackGroupActionsResponse{}
+		expectJSAGA := compactJSON(loadFixtureBytes("testdata/TestResAttackGroup/AttackGroupActions.json"))
+		json.Unmarshal([]byte(expectJSAGA), &agas)
+
+		client.On("GetAttackGroupActions",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetAttackGroupActionsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&agas, nil)
+
+		client.On("UpdateAttackGroupAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateAttackGroupActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Group: "SQL", Action: "alert"},
+		).Return(&cu, nil)
+
+		client.On("UpdateAttackGroupConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.MatchedBy(func(req appsec.UpdateAttackGroupConditionExceptionRequest) bool {
+				return req.ConfigID == 43253 && req.Version == 7 && req.PolicyID == "AAAA_81230" && req.Group == "SQL"
+			}),
+		).Return(&ceu, nil)
+
+		client.On("GetAttackGroupAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetAttackGroupActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Group: "SQL"},
+		).Return(&cr, nil)
+
+		client.On("GetAttackGroupConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetAttackGroupConditionExceptionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Group: "SQL"},
+		).Return(&cer, nil)
+
+		client.On("RemoveAttackGroupConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.RemoveAttackGroupConditionExceptionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Group: "SQL"},
+		).Return(&ced, nil)
+
+		client.On("UpdateAttackGroupAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateAttackGroupActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Group: "SQL", Action: "none"},
+		).Return(&cu, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResAttackGroup/unknown_attack_group.tf"),
+						ExpectError: regexp.MustCompile(`attack_group "UNKNOWN" is not in the rule set of security policy AAAA_81230; valid attack groups are: SQL, XSS`),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResAttackGroup/match_by_id.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_attack_group.test", "id", "43253:7:AAAA_81230:SQL"),
+							resource.TestCheckResourceAttr("akamai_appsec_attack_group.test", "attack_group_action", "alert"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+}
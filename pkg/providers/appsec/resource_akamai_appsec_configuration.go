@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
@@ -23,7 +24,7 @@ func resourceConfiguration() *schema.Resource {
 		UpdateContext: resourceConfigurationUpdate,
 		DeleteContext: resourceConfigurationDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceConfigurationImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -37,15 +38,33 @@ func resourceConfiguration() *schema.Resource {
 			"contract_id": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"group_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"host_names": {
-				Type:     schema.TypeSet,
-				Required: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
+				Type:             schema.TypeSet,
+				Required:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressHostNamesAfterCreate,
+				Description:      "Hostnames selected when the configuration is created",
+			},
+			"create_from_config_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"create_from_version"},
+				Description:  "Config id of the configuration to clone",
+			},
+			"create_from_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"create_from_config_id"},
+				Description:  "Version of the configuration to clone",
 			},
 			"config_id": {
 				Type:     schema.TypeInt,
@@ -99,21 +118,52 @@ func resourceConfigurationCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 	createConfiguration.Hostnames = hnl
 
-	postresp, errc := client.CreateConfiguration(ctx, createConfiguration)
-	if errc != nil {
-		logger.Errorf("calling 'createConfiguration': %s", errc.Error())
-		return diag.FromErr(errc)
+	createFromConfigID, err := tools.GetIntValue("create_from_config_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	var configID, version int
+	if createFromConfigID != 0 {
+		createFromVersion, err := tools.GetIntValue("create_from_version", d)
+		if err != nil && !errors.Is(err, tools.ErrNotFound) {
+			return diag.FromErr(err)
+		}
+
+		createConfigurationClone := appsec.CreateConfigurationCloneRequest{
+			Name:        createConfiguration.Name,
+			Description: createConfiguration.Description,
+			ContractID:  createConfiguration.ContractID,
+			GroupID:     createConfiguration.GroupID,
+			Hostnames:   createConfiguration.Hostnames,
+		}
+		createConfigurationClone.CreateFrom.ConfigID = createFromConfigID
+		createConfigurationClone.CreateFrom.Version = createFromVersion
+
+		ccr, errc := client.CreateConfigurationClone(ctx, createConfigurationClone)
+		if errc != nil {
+			logger.Errorf("calling 'createConfigurationClone': %s", errc.Error())
+			return diag.FromErr(errc)
+		}
+		configID, version = ccr.ConfigID, ccr.Version
+	} else {
+		postresp, errc := client.CreateConfiguration(ctx, createConfiguration)
+		if errc != nil {
+			logger.Errorf("calling 'createConfiguration': %s", errc.Error())
+			return diag.FromErr(errc)
+		}
+		configID, version = postresp.ConfigID, postresp.Version
 	}
 
-	if err := d.Set("config_id", postresp.ConfigID); err != nil {
+	if err := d.Set("config_id", configID); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
-	if err := d.Set("version", postresp.Version); err != nil {
+	if err := d.Set("version", version); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
-	d.SetId(strconv.Itoa(postresp.ConfigID))
+	d.SetId(strconv.Itoa(configID))
 
 	return resourceConfigurationRead(ctx, d, m)
 }
@@ -182,34 +232,36 @@ func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, m in
 	client := inst.Client(meta)
 	logger := meta.Log("APPSEC", "resourceConfigurationRead")
 
-	getConfiguration := appsec.GetConfigurationsRequest{}
-
 	ID, errconv := strconv.Atoi(d.Id())
 
 	if errconv != nil {
 		return diag.FromErr(errconv)
 	}
-	getConfiguration.ConfigID = ID
-
-	configName, err := tools.GetStringValue("name", d)
-	if err != nil && !errors.Is(err, tools.ErrNotFound) {
-		return diag.FromErr(err)
-	}
-	getConfiguration.Name = configName
 
-	configuration, err := client.GetConfigurations(ctx, getConfiguration)
+	configuration, err := client.GetConfigurations(ctx, appsec.GetConfigurationsRequest{ConfigID: ID})
 	if err != nil {
 		logger.Errorf("calling 'getConfiguration': %s", err.Error())
 		return diag.FromErr(err)
 	}
 
-	var configlist string
 	var configidfound int
-	configlist = configlist + " ConfigID Name  VersionList" + "\n"
 
 	for _, configval := range configuration.Configurations {
 
-		if configval.Name == configName {
+		if configval.ID == ID {
+			if err := d.Set("name", configval.Name); err != nil {
+				return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+			}
+			if err := d.Set("description", configval.Description); err != nil {
+				return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+			}
+			// imported configurations have no creation version, use the latest one
+			if d.Get("version").(int) == 0 {
+				if err := d.Set("version", configval.LatestVersion); err != nil {
+					return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+				}
+			}
+
 			d.Set("config_id", configval.ID)
 			d.Set("latest_version", configval.LatestVersion)
 			d.Set("staging_version", configval.StagingVersion)
@@ -226,7 +278,51 @@ func resourceConfigurationRead(ctx context.Context, d *schema.ResourceData, m in
 		d.Set("output_text", outputtext)
 	}
 
+	if configidfound == 0 {
+		logger.Warnf("configuration %d not found, removing from state", ID)
+		d.SetId("")
+		return nil
+	}
+
 	d.SetId(strconv.Itoa(configidfound))
 
 	return nil
 }
+
+// resourceConfigurationImport imports a configuration by "config_id:contract_id:group_id", since the API doesn't
+// return the contract and group a configuration belongs to
+func resourceConfigurationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	meta := akamai.Meta(m)
+	logger := meta.Log("APPSEC", "resourceConfigurationImport")
+
+	parts := strings.Split(d.Id(), ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("colon-separated config ID, contract ID and group ID have to be supplied in import: %s", d.Id())
+	}
+
+	if _, err := strconv.Atoi(parts[0]); err != nil {
+		return nil, fmt.Errorf("invalid config ID %q: %w", parts[0], err)
+	}
+	groupID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid group ID %q: %w", parts[2], err)
+	}
+
+	if err := d.Set("contract_id", parts[1]); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := d.Set("group_id", groupID); err != nil {
+		return nil, fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(parts[0])
+	logger.Debugf("Import configuration: %s", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressHostNamesAfterCreate ignores changes to host_names once the configuration exists. The hostnames are only
+// the initial selection: later selections are managed by akamai_appsec_selected_hostnames, possibly several resources
+// in APPEND mode, so the configuration neither reads them back nor replaces them.
+func suppressHostNamesAfterCreate(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
@@ -32,17 +32,21 @@ func TestAccAkamaiConfiguration_res_basic(t *testing.T) {
 		client.On("CreateConfiguration",
 			mock.Anything, // ctx is irrelevant for this test
 			appsec.CreateConfigurationRequest{Name: "Akamai Tools New", Description: "TF Tools", ContractID: "C-1FRYVV3", GroupID: 64867, Hostnames: []string{"rinaldi.sandbox.akamaideveloper.com", "sujala.sandbox.akamaideveloper.com"}},
-		).Return(&ccr, nil)
+		).Return(&ccr, nil).Run(func(args mock.Arguments) {
+			setConfigurationDescription(&cr, 432531, "TF Tools")
+		})
 
 		client.On("GetConfigurations",
 			mock.Anything, // ctx is irrelevant for this test
-			appsec.GetConfigurationsRequest{ConfigID: 432531, Name: "Akamai Tools New"},
+			appsec.GetConfigurationsRequest{ConfigID: 432531},
 		).Return(&cr, nil)
 
 		client.On("UpdateConfiguration",
 			mock.Anything, // ctx is irrelevant for this test
 			appsec.UpdateConfigurationRequest{ConfigID: 432531, Name: "Akamai Tools New", Description: "TF Tools 1"},
-		).Return(&cu, nil)
+		).Return(&cu, nil).Run(func(args mock.Arguments) {
+			setConfigurationDescription(&cr, 432531, "TF Tools 1")
+		})
 
 		client.On("RemoveConfiguration",
 			mock.Anything, // ctx is irrelevant for this test
@@ -66,6 +70,80 @@ func TestAccAkamaiConfiguration_res_basic(t *testing.T) {
 							resource.TestCheckResourceAttr("akamai_appsec_configuration.test", "id", "432531"),
 						),
 					},
+					{
+						Config:            loadFixtureString("testdata/TestResConfiguration/update_by_id.tf"),
+						ResourceName:      "akamai_appsec_configuration.test",
+						ImportState:       true,
+						ImportStateId:     "432531:C-1FRYVV3:64867",
+						ImportStateVerify: true,
+						// the creation version is not known on import, the latest one is used instead, and host_names
+						// is only the initial selection, which is not read back
+						ImportStateVerifyIgnore: []string{"version", "host_names"},
+					},
+					{
+						Config:   loadFixtureString("testdata/TestResConfiguration/update_by_id.tf"),
+						PlanOnly: true,
+					},
+					{
+						// host_names changes after creation are left to akamai_appsec_selected_hostnames
+						Config:   loadFixtureString("testdata/TestResConfiguration/update_host_names.tf"),
+						PlanOnly: true,
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("clone from existing configuration", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cr := appsec.GetConfigurationsResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResConfiguration/Configuration.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		crd := appsec.RemoveConfigurationResponse{}
+		expectJSD := compactJSON(loadFixtureBytes("testdata/TestResConfiguration/Configuration.json"))
+		json.Unmarshal([]byte(expectJSD), &crd)
+
+		ccr := appsec.CreateConfigurationCloneResponse{}
+		expectJSC := compactJSON(loadFixtureBytes("testdata/TestResConfiguration/ConfigurationClone.json"))
+		json.Unmarshal([]byte(expectJSC), &ccr)
+
+		createClone := appsec.CreateConfigurationCloneRequest{Name: "Akamai Tools New", Description: "TF Tools", ContractID: "C-1FRYVV3", GroupID: 64867, Hostnames: []string{"rinaldi.sandbox.akamaideveloper.com", "sujala.sandbox.akamaideveloper.com"}}
+		createClone.CreateFrom.ConfigID = 43253
+		createClone.CreateFrom.Version = 7
+
+		client.On("CreateConfigurationClone",
+			mock.Anything, // ctx is irrelevant for this test
+			createClone,
+		).Return(&ccr, nil).Run(func(args mock.Arguments) {
+			setConfigurationDescription(&cr, 432531, "TF Tools")
+		})
+
+		client.On("GetConfigurations",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetConfigurationsRequest{ConfigID: 432531},
+		).Return(&cr, nil)
+
+		client.On("RemoveConfiguration",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.RemoveConfigurationRequest{ConfigID: 432531},
+		).Return(&crd, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResConfiguration/clone_by_id.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_configuration.test", "id", "432531"),
+							resource.TestCheckResourceAttr("akamai_appsec_configuration.test", "version", "1"),
+						),
+					},
 				},
 			})
 		})
@@ -74,3 +152,12 @@ func TestAccAkamaiConfiguration_res_basic(t *testing.T) {
 	})
 
 }
+
+// setConfigurationDescription updates the description returned by the GetConfigurations mock
+func setConfigurationDescription(cr *appsec.GetConfigurationsResponse, configID int, description string) {
+	for i := range cr.Configurations {
+		if cr.Configurations[i].ID == configID {
+			cr.Configurations[i].Description = description
+		}
+	}
+}
@@ -20,7 +20,6 @@ func resourceConfigurationVersionClone() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceConfigurationVersionCloneCreate,
 		ReadContext:   resourceConfigurationVersionCloneRead,
-		UpdateContext: resourceConfigurationVersionCloneCreate,
 		DeleteContext: resourceConfigurationVersionCloneDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -29,16 +28,19 @@ func resourceConfigurationVersionClone() *schema.Resource {
 			"config_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"create_from_version": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 
 			"rule_update": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
+				ForceNew: true,
 			},
 			"version": {
 				Type:        schema.TypeInt,
@@ -58,6 +60,7 @@ func resourceConfigurationVersionCloneCreate(ctx context.Context, d *schema.Reso
 
 	createConfigurationVersionClone.ConfigID = d.Get("config_id").(int)
 	createConfigurationVersionClone.CreateFromVersion = d.Get("create_from_version").(int)
+	createConfigurationVersionClone.RuleUpdate = d.Get("rule_update").(bool)
 
 	ccr, err := client.CreateConfigurationVersionClone(ctx, createConfigurationVersionClone)
 	if err != nil {
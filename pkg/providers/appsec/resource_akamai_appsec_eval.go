@@ -228,6 +228,13 @@ func resourceEvalDelete(ctx context.Context, d *schema.ResourceData, m interface
 		}
 		removeEval.PolicyID = policyid
 	}
+	// Nothing to stop once the evaluation has been stopped or completed.
+	if evalstatus, err := tools.GetStringValue("eval_status", d); err == nil && evalstatus == "disabled" {
+		logger.Debugf("evaluation is not running for policy %s, skipping stop", removeEval.PolicyID)
+		d.SetId("")
+		return nil
+	}
+
 	removeEval.Eval = "STOP"
 
 	_, erru := client.RemoveEval(ctx, removeEval)
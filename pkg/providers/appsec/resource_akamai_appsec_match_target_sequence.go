@@ -35,9 +35,10 @@ func resourceMatchTargetSequence() *schema.Resource {
 				Required: true,
 			},
 			"match_target_sequence": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsJSON,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentMatchTargetSequenceDiffs,
 			},
 		},
 	}
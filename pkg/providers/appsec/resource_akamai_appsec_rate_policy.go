@@ -79,7 +79,7 @@ func resourceRatePolicyCreate(ctx context.Context, d *schema.ResourceData, m int
 
 	ratepolicy, err := client.CreateRatePolicy(ctx, createRatePolicy)
 	if err != nil {
-		logger.Warnf("calling 'createRatePolicyAction': %s", err.Error())
+		logger.Errorf("calling 'createRatePolicy': %s", err.Error())
 		return diag.FromErr(err)
 	}
 
@@ -151,7 +151,7 @@ func resourceRatePolicyUpdate(ctx context.Context, d *schema.ResourceData, m int
 	}
 	_, erru := client.UpdateRatePolicy(ctx, updateRatePolicy)
 	if erru != nil {
-		logger.Warnf("calling 'updateRatePolicyAction': %s", erru.Error())
+		logger.Errorf("calling 'updateRatePolicy': %s", erru.Error())
 		return diag.FromErr(erru)
 	}
 
@@ -207,7 +207,7 @@ func resourceRatePolicyDelete(ctx context.Context, d *schema.ResourceData, m int
 	}
 	_, errd := client.RemoveRatePolicy(ctx, deleteRatePolicy)
 	if errd != nil {
-		logger.Warnf("calling 'removeRatePolicyAction': %s", errd.Error())
+		logger.Errorf("calling 'removeRatePolicy': %s", errd.Error())
 		return diag.FromErr(errd)
 	}
 
@@ -273,7 +273,7 @@ func resourceRatePolicyRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	ratepolicy, errd := client.GetRatePolicy(ctx, getRatePolicy)
 	if errd != nil {
-		logger.Warnf("calling 'getRatePolicyAction': %s", errd.Error())
+		logger.Errorf("calling 'getRatePolicy': %s", errd.Error())
 		return diag.FromErr(errd)
 	}
 
@@ -124,7 +124,6 @@ func resourceRatePolicyActionRead(ctx context.Context, d *schema.ResourceData, m
 		logger.Errorf("calling 'getRatePolicyAction': %s", err.Error())
 		return diag.FromErr(err)
 	}
-	logger.Warnf("calling 'GetRatePolicyAction': %s", ratepolicyaction)
 
 	for _, configval := range ratepolicyaction.RatePolicyActions {
 		if configval.ID == getRatePolicyAction.ID {
@@ -150,6 +149,10 @@ func resourceRatePolicyActionRead(ctx context.Context, d *schema.ResourceData, m
 				return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 			}
 
+			if err := d.Set("rate_policy_id", getRatePolicyAction.ID); err != nil {
+				return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+			}
+
 			d.SetId(fmt.Sprintf("%d:%d:%s:%d", getRatePolicyAction.ConfigID, getRatePolicyAction.Version, getRatePolicyAction.PolicyID, getRatePolicyAction.ID))
 
 		}
@@ -215,12 +218,11 @@ func resourceRatePolicyActionDelete(ctx context.Context, d *schema.ResourceData,
 	updateRatePolicyAction.Ipv4Action = "none"
 	updateRatePolicyAction.Ipv6Action = "none"
 
-	resp, erru := client.UpdateRatePolicyAction(ctx, updateRatePolicyAction)
+	_, erru := client.UpdateRatePolicyAction(ctx, updateRatePolicyAction)
 	if erru != nil {
 		logger.Errorf("calling 'removeRatePolicyAction': %s", erru.Error())
 		return diag.FromErr(erru)
 	}
-	logger.Warnf("calling 'RemoveRatePolicyAction': %s", resp)
 	d.SetId("")
 
 	return nil
@@ -299,13 +301,12 @@ func resourceRatePolicyActionUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	updateRatePolicyAction.Ipv6Action = ipv6action
-	logger.Warnf("calling 'updateRatePolicyAction REQ': %s", updateRatePolicyAction)
+
 	resp, erru := client.UpdateRatePolicyAction(ctx, updateRatePolicyAction)
 	if erru != nil {
 		logger.Errorf("calling 'updateRatePolicyAction': %s", erru.Error())
 		return diag.FromErr(erru)
 	}
-	logger.Warnf("calling 'updateRatePolicyAction': %s", resp)
 
 	d.SetId(strconv.Itoa(resp.ID))
 
@@ -138,6 +138,10 @@ func resourceReputationProfileActionRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
+	if err := d.Set("reputation_profile_id", getReputationProfileAction.ReputationProfileID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
 	d.SetId(fmt.Sprintf("%d:%d:%s:%d", getReputationProfileAction.ConfigID, getReputationProfileAction.Version, getReputationProfileAction.PolicyID, getReputationProfileAction.ReputationProfileID))
 
 	return nil
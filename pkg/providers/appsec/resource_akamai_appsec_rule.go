@@ -0,0 +1,240 @@
+package appsec
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// appsec v1
+//
+// https://developer.akamai.com/api/cloud_security/application_security/v1.html
+func resourceRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRuleUpdate,
+		ReadContext:   resourceRuleRead,
+		UpdateContext: resourceRuleUpdate,
+		DeleteContext: resourceRuleDelete,
+		CustomizeDiff: validateRuleIDCustomDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"config_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"security_policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule_action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateActions,
+			},
+			"condition_exception": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONDiffsConditionException,
+			},
+		},
+	}
+}
+
+// getRuleID returns the identifiers of the rule along with the numeric rule ID.
+func getRuleID(d *schema.ResourceData) (*policyItemID, int, error) {
+	id, err := getPolicyItemID(d, "rule_id")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ruleid, err := strconv.Atoi(id.item)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid rule_id %q: %w", id.item, err)
+	}
+
+	return id, ruleid, nil
+}
+
+// ruleConditionExceptionOps returns the condition exception operations of the rule.
+func ruleConditionExceptionOps(client appsec.APPSEC, logger log.Interface, id *policyItemID, ruleid int) conditionExceptionOps {
+	return conditionExceptionOps{
+		update: func(ctx context.Context, payload json.RawMessage) error {
+			_, err := client.UpdateRuleConditionException(ctx, appsec.UpdateRuleConditionExceptionRequest{
+				ConfigID:       id.configID,
+				Version:        id.version,
+				PolicyID:       id.policyID,
+				RuleID:         ruleid,
+				JsonPayloadRaw: payload,
+			})
+			if err != nil {
+				logger.Errorf("calling 'updateRuleConditionException': %s", err.Error())
+			}
+			return err
+		},
+		remove: func(ctx context.Context) error {
+			_, err := client.RemoveRuleConditionException(ctx, appsec.RemoveRuleConditionExceptionRequest{
+				ConfigID: id.configID,
+				Version:  id.version,
+				PolicyID: id.policyID,
+				RuleID:   ruleid,
+			})
+			if err != nil {
+				logger.Errorf("calling 'removeRuleConditionException': %s", err.Error())
+			}
+			return err
+		},
+	}
+}
+
+func resourceRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceRuleRead")
+
+	id, ruleid, err := getRuleID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleaction, err := client.GetRuleAction(ctx, appsec.GetRuleActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		RuleID:   ruleid,
+	})
+	if err != nil {
+		logger.Errorf("calling 'getRuleAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	ruleconditionexception, err := client.GetRuleConditionException(ctx, appsec.GetRuleConditionExceptionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		RuleID:   ruleid,
+	})
+	if err != nil {
+		logger.Errorf("calling 'getRuleConditionException': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("config_id", id.configID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("version", id.version); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("security_policy_id", id.policyID); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("rule_id", ruleid); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	if err := d.Set("rule_action", ruleaction.Action); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	isSet := len(ruleconditionexception.Conditions) > 0 || ruleconditionexception.Exception != nil
+	if err := setConditionException(d, isSet, ruleconditionexception); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+
+	return nil
+}
+
+func resourceRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceRuleRemove")
+
+	id, ruleid, err := getRuleID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := removeConditionException(ctx, d, ruleConditionExceptionOps(client, logger, id, ruleid)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateRuleAction(ctx, appsec.UpdateRuleActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		RuleID:   ruleid,
+		Action:   "none",
+	})
+	if err != nil {
+		logger.Errorf("calling 'removeRuleAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceRuleUpdate")
+
+	id, ruleid, err := getRuleID(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ruleaction, err := tools.GetStringValue("rule_action", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateRuleAction(ctx, appsec.UpdateRuleActionRequest{
+		ConfigID: id.configID,
+		Version:  id.version,
+		PolicyID: id.policyID,
+		RuleID:   ruleid,
+		Action:   ruleaction,
+	})
+	if err != nil {
+		logger.Errorf("calling 'updateRuleAction': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	if err := applyConditionException(ctx, d, ruleConditionExceptionOps(client, logger, id, ruleid)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+
+	return resourceRuleRead(ctx, d, m)
+}
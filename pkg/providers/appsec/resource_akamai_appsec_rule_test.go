@@ -0,0 +1,119 @@
+package appsec
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccAkamaiRule_res_basic(t *testing.T) {
+	t.Run("match by Rule ID", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cu := appsec.UpdateRuleActionResponse{}
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResRule/RuleAction.json"))
+		json.Unmarshal([]byte(expectJSU), &cu)
+
+		cr := appsec.GetRuleActionResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResRule/RuleAction.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		ceu := appsec.UpdateRuleConditionExceptionResponse{}
+		expectJSCEU := compactJSON(loadFixtureBytes("testdata/TestResRule/RuleConditionException.json"))
+		json.Unmarshal([]byte(expectJSCEU), &ceu)
+
+		cer := appsec.GetRuleConditionExceptionResponse{}
+		expectJSCE := compactJSON(loadFixtureBytes("testdata/TestResRule/RuleConditionException.json"))
+		json.Unmarshal([]byte(expectJSCE), &cer)
+
+		ced := appsec.RemoveRuleConditionExceptionResponse{}
+
+		ras := appsec.GetRuleActionsResponse{}
+		expectJSRA := compactJSON(loadFixtureBytes("testdata/TestResRule/RuleActions.json"))
+		json.Unmarshal([]byte(expectJSRA), &ras)
+
+		client.On("GetRuleActions",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetRuleActionsRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&ras, nil)
+
+		client.On("UpdateRuleAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateRuleActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", RuleID: 3000080, Action: "alert"},
+		).Return(&cu, nil)
+
+		// the cookie exclusion from match_by_id.tf must be sent as configured
+		var cookieExclusion interface{}
+		json.Unmarshal([]byte(expectJSCEU), &cookieExclusion)
+
+		client.On("UpdateRuleConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.MatchedBy(func(req appsec.UpdateRuleConditionExceptionRequest) bool {
+				var payload interface{}
+				if err := json.Unmarshal(req.JsonPayloadRaw, &payload); err != nil {
+					return false
+				}
+				return req.ConfigID == 43253 && req.Version == 7 && req.PolicyID == "AAAA_81230" && req.RuleID == 3000080 &&
+					reflect.DeepEqual(payload, cookieExclusion)
+			}),
+		).Return(&ceu, nil)
+
+		client.On("GetRuleAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetRuleActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", RuleID: 3000080},
+		).Return(&cr, nil)
+
+		client.On("GetRuleConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetRuleConditionExceptionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", RuleID: 3000080},
+		).Return(&cer, nil)
+
+		client.On("RemoveRuleConditionException",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.RemoveRuleConditionExceptionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", RuleID: 3000080},
+		).Return(&ced, nil).Once().Run(func(args mock.Arguments) {
+			cer = appsec.GetRuleConditionExceptionResponse{}
+		})
+
+		client.On("UpdateRuleAction",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateRuleActionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", RuleID: 3000080, Action: "none"},
+		).Return(&cu, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResRule/unknown_rule.tf"),
+						ExpectError: regexp.MustCompile(`rule_id 999999 is not in the rule set of security policy AAAA_81230; valid rule IDs are: 3000080 3000081`),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResRule/match_by_id.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_rule.test", "id", "43253:7:AAAA_81230:3000080"),
+							resource.TestCheckResourceAttr("akamai_appsec_rule.test", "rule_action", "alert"),
+							resource.TestMatchResourceAttr("akamai_appsec_rule.test", "condition_exception", regexp.MustCompile(`"names":\["session_id"\],"selector":"REQUEST_COOKIES"`)),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResRule/remove_exception.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_rule.test", "id", "43253:7:AAAA_81230:3000080"),
+							resource.TestCheckResourceAttr("akamai_appsec_rule.test", "condition_exception", ""),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+}
@@ -42,6 +42,10 @@ func resourceRuleUpgrade() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"latest_ruleset": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"mode": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -87,12 +91,22 @@ func resourceRuleUpgradeRead(ctx context.Context, d *schema.ResourceData, m inte
 	}
 	getRuleUpgrade.PolicyID = policyid
 
-	_, errr := client.GetRuleUpgrade(ctx, getRuleUpgrade)
+	ruleupgrade, errr := client.GetRuleUpgrade(ctx, getRuleUpgrade)
 	if errr != nil {
 		logger.Errorf("calling 'getRuleUpgrade': %s", errr.Error())
 		return diag.FromErr(errr)
 	}
 
+	if ruleupgrade.Current != "" {
+		if err := d.Set("current_ruleset", ruleupgrade.Current); err != nil {
+			return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+		}
+	}
+
+	if err := d.Set("latest_ruleset", ruleupgrade.Latest); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
 	d.SetId(strconv.Itoa(getRuleUpgrade.ConfigID))
 
 	return nil
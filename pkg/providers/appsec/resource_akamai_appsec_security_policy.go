@@ -30,6 +30,7 @@ func resourceSecurityPolicy() *schema.Resource {
 			"config_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
@@ -42,11 +43,20 @@ func resourceSecurityPolicy() *schema.Resource {
 			"security_policy_prefix": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"default_settings": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       true,
+				ForceNew:      true,
+				ConflictsWith: []string{"create_from_security_policy"},
+			},
+			"create_from_security_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Policy ID of the security policy to clone",
 			},
 			"security_policy_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -94,26 +104,89 @@ func resourceSecurityPolicyCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 	createSecurityPolicy.PolicyPrefix = policyprefix
 
-	spcr, errc := client.CreateSecurityPolicy(ctx, createSecurityPolicy)
-	if errc != nil {
-		logger.Errorf("calling 'createSecurityPolicy': %s", errc.Error())
-		return diag.FromErr(errc)
+	createFromSecurityPolicy, err := tools.GetStringValue("create_from_security_policy", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
 	}
 
-	if err := d.Set("security_policy_id", spcr.PolicyID); err != nil {
+	var policyID, policyName string
+	if createFromSecurityPolicy != "" {
+		createSecurityPolicyClone := appsec.CreateSecurityPolicyCloneRequest{
+			ConfigID:                 createSecurityPolicy.ConfigID,
+			Version:                  createSecurityPolicy.Version,
+			CreateFromSecurityPolicy: createFromSecurityPolicy,
+			PolicyName:               createSecurityPolicy.PolicyName,
+			PolicyPrefix:             createSecurityPolicy.PolicyPrefix,
+		}
+
+		spcr, errc := client.CreateSecurityPolicyClone(ctx, createSecurityPolicyClone)
+		if errc != nil {
+			logger.Errorf("calling 'createSecurityPolicyClone': %s", errc.Error())
+			return diag.FromErr(errc)
+		}
+		policyID, policyName = spcr.PolicyID, spcr.PolicyName
+	} else {
+		spcr, errc := client.CreateSecurityPolicy(ctx, createSecurityPolicy)
+		if errc != nil {
+			logger.Errorf("calling 'createSecurityPolicy': %s", errc.Error())
+			return diag.FromErr(errc)
+		}
+		policyID, policyName = spcr.PolicyID, spcr.PolicyName
+	}
+
+	if err := d.Set("security_policy_id", policyID); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
-	if err := d.Set("security_policy_name", spcr.PolicyName); err != nil {
+	if err := d.Set("security_policy_name", policyName); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
-	d.SetId(fmt.Sprintf("%d:%d:%s", createSecurityPolicy.ConfigID, createSecurityPolicy.Version, spcr.PolicyID))
+	d.SetId(fmt.Sprintf("%d:%d:%s", createSecurityPolicy.ConfigID, createSecurityPolicy.Version, policyID))
 
 	return resourceSecurityPolicyRead(ctx, d, m)
 }
 
 func resourceSecurityPolicyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChange("security_policy_name") {
+		return resourceSecurityPolicyRead(ctx, d, m)
+	}
+
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceSecurityPolicyUpdate")
+
+	updateSecurityPolicy := appsec.UpdateSecurityPolicyRequest{}
+
+	configid, err := tools.GetIntValue("config_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	updateSecurityPolicy.ConfigID = configid
+
+	version, err := tools.GetIntValue("version", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	updateSecurityPolicy.Version = version
+
+	securitypolicyid, err := tools.GetStringValue("security_policy_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	updateSecurityPolicy.PolicyID = securitypolicyid
+
+	policyname, err := tools.GetStringValue("security_policy_name", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	updateSecurityPolicy.PolicyName = policyname
+
+	_, erru := client.UpdateSecurityPolicy(ctx, updateSecurityPolicy)
+	if erru != nil {
+		logger.Errorf("calling 'updateSecurityPolicy': %s", erru.Error())
+		return diag.FromErr(erru)
+	}
 
 	return resourceSecurityPolicyRead(ctx, d, m)
 }
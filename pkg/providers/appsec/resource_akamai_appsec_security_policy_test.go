@@ -59,4 +59,53 @@ func TestAccAkamaiSecurityPolicy_res_basic(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("clone from SecurityPolicy ID", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cr := appsec.GetSecurityPolicyResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResSecurityPolicy/SecurityPolicy.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		crp := appsec.CreateSecurityPolicyCloneResponse{}
+		expectJSC := compactJSON(loadFixtureBytes("testdata/TestResSecurityPolicy/SecurityPolicyCreate.json"))
+		json.Unmarshal([]byte(expectJSC), &crp)
+
+		rp := appsec.RemoveSecurityPolicyResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResSecurityPolicy/SecurityPolicy.json"))
+		json.Unmarshal([]byte(expectJSR), &rp)
+
+		client.On("GetSecurityPolicy",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetSecurityPolicyRequest{ConfigID: 43253, Version: 7, PolicyID: "PLE_114049"},
+		).Return(&cr, nil)
+
+		client.On("CreateSecurityPolicyClone",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.CreateSecurityPolicyCloneRequest{ConfigID: 43253, Version: 7, CreateFromSecurityPolicy: "LNPD_76189", PolicyName: "Cloned Test for Launchpad 15", PolicyPrefix: "PLE"},
+		).Return(&crp, nil)
+
+		client.On("RemoveSecurityPolicy",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.RemoveSecurityPolicyRequest{ConfigID: 43253, Version: 7, PolicyID: "PLE_114049"},
+		).Return(&rp, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResSecurityPolicy/clone_by_id.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_security_policy.test", "id", "43253:7:PLE_114049"),
+						),
+						ExpectNonEmptyPlan: true,
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -25,6 +26,9 @@ func resourceSelectedHostname() *schema.Resource {
 		ReadContext:   resourceSelectedHostnameRead,
 		UpdateContext: resourceSelectedHostnameUpdate,
 		DeleteContext: resourceSelectedHostnameDelete,
+		CustomizeDiff: customdiff.ComputedIf("added_hostnames", func(_ context.Context, d *schema.ResourceDiff, _ interface{}) bool {
+			return d.HasChange("hostnames")
+		}),
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -32,6 +36,7 @@ func resourceSelectedHostname() *schema.Resource {
 			"config_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
@@ -45,12 +50,19 @@ func resourceSelectedHostname() *schema.Resource {
 			"mode": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					Append,
 					Replace,
 					Remove,
 				}, false),
 			},
+			"added_hostnames": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Hostnames selected by this resource in APPEND mode, which were not selected before",
+			},
 		},
 	}
 }
@@ -177,8 +189,53 @@ func resourceSelectedHostnameRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceSelectedHostnameDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	logger := meta.Log("APPSEC", "resourceSelectedHostnameRemove")
 
-	return schema.NoopContext(nil, d, m)
+	// Only hostnames contributed in APPEND mode are owned by this resource; REPLACE and REMOVE
+	// leave the configuration as it is on destroy.
+	mode := d.Get("mode").(string)
+	if mode != Append {
+		return schema.NoopContext(ctx, d, m)
+	}
+
+	configid, err := tools.GetIntValue("config_id", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	version, err := tools.GetIntValue("version", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+
+	// hostnames which were already selected before this resource appended them are left in place
+	added := d.Get("added_hostnames").(*schema.Set)
+	if added.Len() == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	selectedhostnames, err := client.GetSelectedHostnames(ctx, appsec.GetSelectedHostnamesRequest{ConfigID: configid, Version: version})
+	if err != nil {
+		logger.Errorf("calling 'getSelectedHostnames': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	_, err = client.UpdateSelectedHostname(ctx, appsec.UpdateSelectedHostnameRequest{
+		ConfigID:     configid,
+		Version:      version,
+		HostnameList: mergeSelectedHostnames(selectedhostnames.HostnameList, tools.SetToStringSlice(added), Remove),
+	})
+	if err != nil {
+		logger.Errorf("calling 'updateSelectedHostname': %s", err.Error())
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
 }
 
 func resourceSelectedHostnameUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -225,16 +282,8 @@ func resourceSelectedHostnameUpdate(ctx context.Context, d *schema.ResourceData,
 	}
 	mode := d.Get("mode").(string)
 
-	hn := appsec.GetSelectedHostnamesRequest{}
-
 	hostnamelist := d.Get("hostnames").(*schema.Set)
 
-	for _, h := range hostnamelist.List() {
-		h1 := appsec.Hostname{}
-		h1.Hostname = h.(string)
-		hn.HostnameList = append(hn.HostnameList, h1)
-	}
-
 	getSelectedHostnames := appsec.GetSelectedHostnamesRequest{}
 	getSelectedHostnames.ConfigID = updateSelectedHostname.ConfigID
 	getSelectedHostnames.Version = updateSelectedHostname.Version
@@ -245,38 +294,85 @@ func resourceSelectedHostnameUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	updateSelectedHostname.HostnameList = mergeSelectedHostnames(selectedhostnames.HostnameList, tools.SetToStringSlice(hostnamelist), mode)
+
+	if mode == Append {
+		// added_hostnames is recomputed whenever hostnames change, so the prior value comes from the state
+		oldadded, _ := d.GetChange("added_hostnames")
+		added := appendedHostnames(selectedhostnames.HostnameList, oldadded.(*schema.Set), hostnamelist)
+
+		// hostnames dropped from the configuration are deselected, provided this resource selected them
+		oldhostnames, _ := d.GetChange("hostnames")
+		dropped := oldhostnames.(*schema.Set).Difference(hostnamelist).Intersection(oldadded.(*schema.Set))
+		updateSelectedHostname.HostnameList = mergeSelectedHostnames(updateSelectedHostname.HostnameList, tools.SetToStringSlice(dropped), Remove)
+
+		if err := d.Set("added_hostnames", tools.SetToStringSlice(added)); err != nil {
+			return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+		}
+	}
+
+	_, erru := client.UpdateSelectedHostname(ctx, updateSelectedHostname)
+	if erru != nil {
+		logger.Errorf("calling 'updateSelectedHostname': %s", erru.Error())
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, erru.Error()))
+	}
+
+	return resourceSelectedHostnameRead(ctx, d, m)
+}
+
+// mergeSelectedHostnames applies hostnames to the current selection according to mode: APPEND adds
+// the hostnames not yet selected, REMOVE drops them, and REPLACE uses them as the whole selection.
+func mergeSelectedHostnames(current []appsec.Hostname, hostnames []string, mode string) []appsec.Hostname {
+	requested := make(map[string]bool, len(hostnames))
+	for _, h := range hostnames {
+		requested[h] = true
+	}
+
+	merged := make([]appsec.Hostname, 0, len(current)+len(hostnames))
+
 	switch mode {
 	case Remove:
-		for _, hl := range hostnamelist.List() {
-
-			for idx, h := range selectedhostnames.HostnameList {
-				if h.Hostname == hl.(string) {
-					selectedhostnames.HostnameList = RemoveIndex(selectedhostnames.HostnameList, idx)
-				}
+		for _, h := range current {
+			if !requested[h.Hostname] {
+				merged = append(merged, h)
 			}
 		}
 	case Append:
-		for _, h := range selectedhostnames.HostnameList {
-			m := appsec.Hostname{}
-			m.Hostname = h.Hostname
-			hn.HostnameList = append(hn.HostnameList, m)
+		selected := make(map[string]bool, len(current))
+		for _, h := range current {
+			selected[h.Hostname] = true
+			merged = append(merged, h)
+		}
+		for _, h := range hostnames {
+			if !selected[h] {
+				merged = append(merged, appsec.Hostname{Hostname: h})
+			}
 		}
-		selectedhostnames.HostnameList = hn.HostnameList
-	case Replace:
-		selectedhostnames.HostnameList = hn.HostnameList
 	default:
-		selectedhostnames.HostnameList = hn.HostnameList
+		for _, h := range hostnames {
+			merged = append(merged, appsec.Hostname{Hostname: h})
+		}
 	}
 
-	updateSelectedHostname.HostnameList = selectedhostnames.HostnameList
+	return merged
+}
+
+// appendedHostnames returns the hostnames owned by an APPEND resource after applying hostnames: the previously
+// added ones still configured, plus the configured ones which are not selected yet.
+func appendedHostnames(current []appsec.Hostname, added, hostnames *schema.Set) *schema.Set {
+	selected := make(map[string]bool, len(current))
+	for _, h := range current {
+		selected[h.Hostname] = true
+	}
 
-	_, erru := client.UpdateSelectedHostname(ctx, updateSelectedHostname)
-	if erru != nil {
-		logger.Errorf("calling 'updateSelectedHostname': %s", erru.Error())
-		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, erru.Error()))
+	result := added.Intersection(hostnames)
+	for _, h := range tools.SetToStringSlice(hostnames) {
+		if !selected[h] {
+			result.Add(h)
+		}
 	}
 
-	return resourceSelectedHostnameRead(ctx, d, m)
+	return result
 }
 
 //RemoveIndex reemove host from list
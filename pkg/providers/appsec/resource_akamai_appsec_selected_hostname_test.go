@@ -2,10 +2,13 @@ package appsec
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -69,3 +72,107 @@ func TestAccAkamaiSelectedHostname_res_basic(t *testing.T) {
 	})
 
 }
+
+func TestAccAkamaiSelectedHostname_res_append(t *testing.T) {
+	client := &mockappsec{}
+
+	// rinaldi.sandbox.akamaideveloper.com is selected outside of the resource
+	hns := appsec.GetSelectedHostnamesResponse{}
+	expectJS := compactJSON(loadFixtureBytes("testdata/TestResSelectedHostname/SelectedHostnameAppend.json"))
+	json.Unmarshal([]byte(expectJS), &hns)
+
+	cr := appsec.GetSelectedHostnameResponse{}
+	json.Unmarshal([]byte(expectJS), &cr)
+
+	client.On("GetSelectedHostnames",
+		mock.Anything, // ctx is irrelevant for this test
+		appsec.GetSelectedHostnamesRequest{ConfigID: 43253, Version: 7},
+	).Return(&hns, nil)
+
+	client.On("GetSelectedHostname",
+		mock.Anything, // ctx is irrelevant for this test
+		appsec.GetSelectedHostnameRequest{ConfigID: 43253, Version: 7},
+	).Return(&cr, nil)
+
+	// expectUpdate expects the selection to be updated to hostnames once, and returns it from then on
+	expectUpdate := func(hostnames ...string) {
+		req := appsec.UpdateSelectedHostnameRequest{ConfigID: 43253, Version: 7, HostnameList: []appsec.Hostname{}}
+		for _, h := range hostnames {
+			req.HostnameList = append(req.HostnameList, appsec.Hostname{Hostname: h})
+		}
+		client.On("UpdateSelectedHostname",
+			mock.Anything, // ctx is irrelevant for this test
+			req,
+		).Return(&appsec.UpdateSelectedHostnameResponse{HostnameList: req.HostnameList}, nil).Once().Run(func(args mock.Arguments) {
+			hns.HostnameList = req.HostnameList
+			cr.HostnameList = req.HostnameList
+		})
+	}
+
+	// create appends sujala
+	expectUpdate("rinaldi.sandbox.akamaideveloper.com", "sujala.sandbox.akamaideveloper.com")
+	// update deselects sujala, which is no longer configured, and appends www.example.com
+	expectUpdate("rinaldi.sandbox.akamaideveloper.com", "www.example.com")
+	// destroy only deselects the hostname appended by the resource
+	expectUpdate("rinaldi.sandbox.akamaideveloper.com")
+
+	useClient(client, func() {
+		resource.UnitTest(t, resource.TestCase{
+			Providers: testAccProviders,
+			Steps: []resource.TestStep{
+				{
+					Config: loadFixtureString("testdata/TestResSelectedHostname/append.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", "id", "43253:7"),
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", "hostnames.#", "2"),
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", "added_hostnames.#", "1"),
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", fmt.Sprintf("added_hostnames.%d", schema.HashString("sujala.sandbox.akamaideveloper.com")), "sujala.sandbox.akamaideveloper.com"),
+					),
+				},
+				{
+					Config: loadFixtureString("testdata/TestResSelectedHostname/append_update.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", "hostnames.#", "2"),
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", "added_hostnames.#", "1"),
+						resource.TestCheckResourceAttr("akamai_appsec_selected_hostnames.test", fmt.Sprintf("added_hostnames.%d", schema.HashString("www.example.com")), "www.example.com"),
+					),
+				},
+			},
+		})
+	})
+
+	client.AssertExpectations(t)
+	assert.Equal(t, []appsec.Hostname{{Hostname: "rinaldi.sandbox.akamaideveloper.com"}}, hns.HostnameList)
+}
+
+func TestMergeSelectedHostnames(t *testing.T) {
+	current := []appsec.Hostname{{Hostname: "a.example.com"}, {Hostname: "b.example.com"}}
+
+	tests := map[string]struct {
+		hostnames []string
+		mode      string
+		expected  []appsec.Hostname
+	}{
+		"append keeps existing hostnames and skips duplicates": {
+			hostnames: []string{"b.example.com", "c.example.com"},
+			mode:      Append,
+			expected:  []appsec.Hostname{{Hostname: "a.example.com"}, {Hostname: "b.example.com"}, {Hostname: "c.example.com"}},
+		},
+		"remove drops only the given hostnames": {
+			hostnames: []string{"a.example.com", "c.example.com"},
+			mode:      Remove,
+			expected:  []appsec.Hostname{{Hostname: "b.example.com"}},
+		},
+		"replace uses the given hostnames": {
+			hostnames: []string{"c.example.com"},
+			mode:      Replace,
+			expected:  []appsec.Hostname{{Hostname: "c.example.com"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, mergeSelectedHostnames(current, test.hostnames, test.mode))
+		})
+	}
+}
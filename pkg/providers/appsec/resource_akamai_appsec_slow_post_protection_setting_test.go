@@ -2,6 +2,7 @@ package appsec
 
 import (
 	"encoding/json"
+	"regexp"
 	"testing"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/appsec"
@@ -63,4 +64,86 @@ func TestAccAkamaiSlowPostProtectionSetting_res_basic(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("settings without slow rate threshold", func(t *testing.T) {
+		client := &mockappsec{}
+
+		cu := appsec.UpdateSlowPostProtectionSettingResponse{}
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResSlowPostProtectionSetting/SlowPostProtectionSettingNoThreshold.json"))
+		json.Unmarshal([]byte(expectJSU), &cu)
+
+		cr := appsec.GetSlowPostProtectionSettingResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResSlowPostProtectionSetting/SlowPostProtectionSettingNoThreshold.json"))
+		json.Unmarshal([]byte(expectJS), &cr)
+
+		cup := appsec.UpdateSlowPostProtectionResponse{}
+
+		client.On("GetSlowPostProtectionSetting",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetSlowPostProtectionSettingRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&cr, nil)
+
+		client.On("UpdateSlowPostProtection",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateSlowPostProtectionRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&cup, nil)
+
+		client.On("UpdateSlowPostProtectionSetting",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateSlowPostProtectionSettingRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Action: "alert", SlowRateThreshold: struct {
+				Rate   int "json:\"rate\""
+				Period int "json:\"period\""
+			}{Rate: 10, Period: 30}, DurationThreshold: struct {
+				Timeout int "json:\"timeout\""
+			}{Timeout: 20}},
+		).Return(&cu, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResSlowPostProtectionSetting/match_by_id.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_slow_post.test", "id", "43253:7:AAAA_81230"),
+							resource.TestCheckResourceAttr("akamai_appsec_slow_post.test", "slow_rate_action", "alert"),
+							// no threshold is returned, the configured values are kept
+							resource.TestCheckResourceAttr("akamai_appsec_slow_post.test", "slow_rate_threshold_rate", "10"),
+							resource.TestCheckResourceAttr("akamai_appsec_slow_post.test", "slow_rate_threshold_period", "30"),
+							resource.TestCheckResourceAttr("akamai_appsec_slow_post.test", "duration_threshold_timeout", "20"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("invalid thresholds", func(t *testing.T) {
+		client := &mockappsec{}
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResSlowPostProtectionSetting/zero_rate.tf"),
+						ExpectError: regexp.MustCompile(`expected slow_rate_threshold_rate to be at least \(1\), got 0`),
+					},
+					{
+						Config:      loadFixtureString("testdata/TestResSlowPostProtectionSetting/negative_period.tf"),
+						ExpectError: regexp.MustCompile(`expected slow_rate_threshold_period to be at least \(1\), got -30`),
+					},
+					{
+						Config:      loadFixtureString("testdata/TestResSlowPostProtectionSetting/negative_timeout.tf"),
+						ExpectError: regexp.MustCompile(`expected duration_threshold_timeout to be at least \(0\), got -1`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
 }
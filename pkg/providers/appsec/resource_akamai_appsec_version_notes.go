@@ -30,6 +30,7 @@ func resourceVersionNotes() *schema.Resource {
 			"config_id": {
 				Type:     schema.TypeInt,
 				Required: true,
+				ForceNew: true,
 			},
 			"version": {
 				Type:     schema.TypeInt,
@@ -112,6 +113,10 @@ func resourceVersionNotesRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
 
+	if err := d.Set("version_notes", versionnotes.Notes); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
 	d.SetId(fmt.Sprintf("%d:%d", getVersionNotes.ConfigID, getVersionNotes.Version))
 
 	return nil
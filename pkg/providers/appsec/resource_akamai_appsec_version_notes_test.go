@@ -14,13 +14,18 @@ func TestAccAkamaiVersionNotes_res_basic(t *testing.T) {
 		client := &mockappsec{}
 
 		cu := appsec.UpdateVersionNotesResponse{}
-		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResVersionNotes/VersionNotes.json"))
+		expectJSU := compactJSON(loadFixtureBytes("testdata/TestResVersionNotes/VersionNotesUpdate.json"))
 		json.Unmarshal([]byte(expectJSU), &cu)
 
 		cr := appsec.GetVersionNotesResponse{}
-		expectJS := compactJSON(loadFixtureBytes("testdata/TestResVersionNotes/VersionNotes.json"))
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResVersionNotes/VersionNotesUpdate.json"))
 		json.Unmarshal([]byte(expectJS), &cr)
 
+		// notes changed outside of Terraform
+		drifted := appsec.GetVersionNotesResponse{}
+		expectJSD := compactJSON(loadFixtureBytes("testdata/TestResVersionNotes/VersionNotes.json"))
+		json.Unmarshal([]byte(expectJSD), &drifted)
+
 		client.On("GetVersionNotes",
 			mock.Anything, // ctx is irrelevant for this test
 			appsec.GetVersionNotesRequest{ConfigID: 43253, Version: 7},
@@ -40,8 +45,17 @@ func TestAccAkamaiVersionNotes_res_basic(t *testing.T) {
 						Config: loadFixtureString("testdata/TestResVersionNotes/match_by_id.tf"),
 						Check: resource.ComposeAggregateTestCheckFunc(
 							resource.TestCheckResourceAttr("akamai_appsec_version_notes.test", "id", "43253:7"),
+							resource.TestCheckResourceAttr("akamai_appsec_version_notes.test", "version_notes", "Test Notes"),
 						),
 					},
+					{
+						PreConfig: func() {
+							cr = drifted
+						},
+						Config:             loadFixtureString("testdata/TestResVersionNotes/match_by_id.tf"),
+						PlanOnly:           true,
+						ExpectNonEmptyPlan: true,
+					},
 				},
 			})
 		})
@@ -46,6 +46,8 @@ func resourceWAFMode() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					AAG,
 					KRS,
+					AseAuto,
+					AseManual,
 				}, false),
 			},
 			"current_ruleset": {
@@ -259,7 +261,14 @@ func resourceWAFModeUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	return resourceWAFModeRead(ctx, d, m)
 }
 
+// WAF modes
 const (
+	// AAG updates the Kona Rule Set automatically
 	AAG = "AAG"
+	// KRS requires Kona Rule Set updates to be applied manually
 	KRS = "KRS"
+	// AseAuto updates the Adaptive Security Engine rule set automatically
+	AseAuto = "ASE_AUTO"
+	// AseManual requires Adaptive Security Engine rule set updates to be applied manually
+	AseManual = "ASE_MANUAL"
 )
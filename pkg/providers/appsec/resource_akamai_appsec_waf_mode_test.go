@@ -49,4 +49,62 @@ func TestAccAkamaiWAFMode_res_basic(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("ASE mode with rule upgrade", func(t *testing.T) {
+		client := &mockappsec{}
+
+		wm := appsec.GetWAFModeResponse{}
+		expectJS := compactJSON(loadFixtureBytes("testdata/TestResWAFMode/WAFModeASE.json"))
+		json.Unmarshal([]byte(expectJS), &wm)
+
+		wmu := appsec.UpdateWAFModeResponse{}
+		json.Unmarshal([]byte(expectJS), &wmu)
+
+		ru := appsec.GetRuleUpgradeResponse{}
+		expectJSR := compactJSON(loadFixtureBytes("testdata/TestResWAFMode/RuleUpgradeASE.json"))
+		json.Unmarshal([]byte(expectJSR), &ru)
+
+		ruu := appsec.UpdateRuleUpgradeResponse{}
+		json.Unmarshal([]byte(expectJSR), &ruu)
+
+		client.On("GetWAFMode",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetWAFModeRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&wm, nil)
+
+		client.On("UpdateWAFMode",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateWAFModeRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Mode: AseAuto},
+		).Return(&wmu, nil)
+
+		client.On("GetRuleUpgrade",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.GetRuleUpgradeRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230"},
+		).Return(&ru, nil)
+
+		client.On("UpdateRuleUpgrade",
+			mock.Anything, // ctx is irrelevant for this test
+			appsec.UpdateRuleUpgradeRequest{ConfigID: 43253, Version: 7, PolicyID: "AAAA_81230", Upgrade: true},
+		).Return(&ruu, nil)
+
+		useClient(client, func() {
+			resource.Test(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResWAFMode/ase_auto.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_appsec_waf_mode.test", "mode", AseAuto),
+							resource.TestCheckResourceAttr("akamai_appsec_waf_mode.test", "current_ruleset", "ASE_AUTO_1.0_2021-02-16"),
+							resource.TestCheckResourceAttr("akamai_appsec_rule_upgrade.test", "mode", AseAuto),
+							resource.TestCheckResourceAttr("akamai_appsec_rule_upgrade.test", "latest_ruleset", "ASE_AUTO_1.1_2021-03-02"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
 }
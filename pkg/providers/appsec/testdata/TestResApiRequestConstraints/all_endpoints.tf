@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+
+resource "akamai_appsec_api_request_constraints" "test" {
+  config_id = 43253
+  version = 7
+  security_policy_id = "AAAA_81230"
+  action = "alert"
+}
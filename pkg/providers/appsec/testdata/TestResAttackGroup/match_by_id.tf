@@ -0,0 +1,25 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_appsec_attack_group" "test" {
+    config_id = 43253
+    version = 7
+    security_policy_id = "AAAA_81230"
+    attack_group = "SQL"
+    attack_group_action = "alert"
+    condition_exception = <<-EOF
+    {
+        "exception": {
+            "headerCookieOrParamValues": [
+                "abc"
+            ],
+            "specificHeaderCookieOrParamPrefix": {
+                "prefix": "a*",
+                "selector": "REQUEST_COOKIES"
+            }
+        }
+    }
+EOF
+}
+
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_appsec_attack_group" "test" {
+    config_id = 43253
+    version = 7
+    security_policy_id = "AAAA_81230"
+    attack_group = "UNKNOWN"
+    attack_group_action = "alert"
+}
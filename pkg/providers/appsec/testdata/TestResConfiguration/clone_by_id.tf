@@ -0,0 +1,15 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_appsec_configuration" "test" {
+  name = "Akamai Tools New"
+  description = "TF Tools"
+  contract_id= "C-1FRYVV3"
+  group_id  = 64867
+  host_names = ["rinaldi.sandbox.akamaideveloper.com",
+        "sujala.sandbox.akamaideveloper.com"]
+  create_from_config_id = 43253
+  create_from_version = 7
+}
+
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_appsec_rule" "test" {
+    config_id = 43253
+    version = 7
+    security_policy_id = "AAAA_81230"
+    rule_id = 3000080
+    rule_action = "alert"
+}
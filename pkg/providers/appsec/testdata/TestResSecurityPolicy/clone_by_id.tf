@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+
+resource "akamai_appsec_security_policy" "test" {
+    config_id = 43253
+    version = 7
+    security_policy_name = "Cloned Test for Launchpad 15"
+    security_policy_prefix = "PLE"
+    create_from_security_policy = "LNPD_76189"
+   }
+
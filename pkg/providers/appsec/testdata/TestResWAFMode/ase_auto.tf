@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_appsec_waf_mode" "test" {
+    config_id = 43253
+    version = 7
+    security_policy_id = "AAAA_81230"
+    mode = "ASE_AUTO"
+}
+
+resource "akamai_appsec_rule_upgrade" "test" {
+    config_id = akamai_appsec_waf_mode.test.config_id
+    version = akamai_appsec_waf_mode.test.version
+    security_policy_id = akamai_appsec_waf_mode.test.security_policy_id
+}
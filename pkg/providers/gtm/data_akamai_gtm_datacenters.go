@@ -0,0 +1,126 @@
+package gtm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGTMDatacenters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGTMDatacentersRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"datacenters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"datacenter_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"nickname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state_or_province": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"continent": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"longitude": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"virtual": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGTMDatacentersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Akamai GTM", "dataSourceGTMDatacentersRead")
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	domain, err := tools.GetStringValue("domain", d)
+	if err != nil {
+		logger.Errorf("[Error] GTM dataSourceGTMDatacentersRead: Domain not initialized")
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	logger.Debugf("Start Datacenters Retrieval for domain %s", domain)
+
+	dcList, err := inst.Client(meta).ListDatacenters(ctx, domain)
+	if err != nil {
+		logger.Errorf("Datacenters Read failed: %s", err.Error())
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Datacenters Read error",
+			Detail:   err.Error(),
+		})
+	}
+
+	datacenters := make([]interface{}, 0, len(dcList))
+	for _, dc := range dcList {
+		datacenters = append(datacenters, map[string]interface{}{
+			"datacenter_id":     dc.DatacenterId,
+			"nickname":          dc.Nickname,
+			"city":              dc.City,
+			"state_or_province": dc.StateOrProvince,
+			"country":           dc.Country,
+			"continent":         dc.Continent,
+			"latitude":          dc.Latitude,
+			"longitude":         dc.Longitude,
+			"virtual":           dc.Virtual,
+		})
+	}
+	if err := d.Set("datacenters", datacenters); err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "GTM dataSourceGTMDatacentersRead: setting datacenters failed.",
+			Detail:   err.Error(),
+		})
+	}
+
+	datacentersID := fmt.Sprintf("%s:%s", domain, "datacenters")
+	logger.Debugf("DataSourceGTMDatacentersRead: generated Datacenters Resource Id: %s", datacentersID)
+	d.SetId(datacentersID)
+
+	return nil
+}
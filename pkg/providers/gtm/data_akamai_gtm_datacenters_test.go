@@ -0,0 +1,65 @@
+package gtm
+
+import (
+	"testing"
+
+	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceGTMDatacenters_basic(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		client := &mockgtm{}
+
+		dcs := []*gtm.Datacenter{
+			{
+				DatacenterId: 3131,
+				Nickname:     "tfexample_dc_1",
+				City:         "Snæfellsjökull",
+				Country:      "IS",
+				Continent:    "EU",
+				Latitude:     64.808,
+				Longitude:    -23.776,
+			},
+			{
+				DatacenterId: 3132,
+				Nickname:     "tfexample_dc_2",
+				City:         "Philadelphia",
+				Country:      "US",
+				Continent:    "NA",
+				Latitude:     39.95,
+				Longitude:    -75.167,
+			},
+		}
+
+		client.On("ListDatacenters",
+			mock.Anything, // ctx is irrelevant for this test
+			"testdomain.net",
+		).Return(dcs, nil)
+
+		dataSourceName := "data.akamai_gtm_datacenters.test"
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDataDatacenters/basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "id", "testdomain.net:datacenters"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenters.#", "2"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenters.0.datacenter_id", "3131"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenters.0.nickname", "tfexample_dc_1"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenters.1.city", "Philadelphia"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenters.1.latitude", "39.95"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,115 @@
+package gtm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGTMDomainLastChange() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGTMDomainLastChangeRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"modification_comments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"change_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"propagation_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"propagation_status_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGTMDomainLastChangeRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Akamai GTM", "dataSourceGTMDomainLastChangeRead")
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	domain, err := tools.GetStringValue("domain", d)
+	if err != nil {
+		logger.Errorf("[Error] GTM dataSourceGTMDomainLastChangeRead: Domain not initialized")
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	logger.Debugf("Start Domain Last Change Retrieval for domain %s", domain)
+
+	dom, err := inst.Client(meta).GetDomain(ctx, domain)
+	if err != nil {
+		logger.Errorf("Domain Last Change Read failed: %s", err.Error())
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Domain Last Change Read error",
+			Detail:   err.Error(),
+		})
+	}
+	domStatus, err := inst.Client(meta).GetDomainStatus(ctx, domain)
+	if err != nil {
+		logger.Errorf("Domain Last Change Status Read failed: %s", err.Error())
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Domain Last Change Status Read error",
+			Detail:   err.Error(),
+		})
+	}
+
+	for stateKey, stateValue := range map[string]interface{}{
+		"last_modified":           dom.LastModified,
+		"last_modified_by":        dom.LastModifiedBy,
+		"modification_comments":   dom.ModificationComments,
+		"change_id":               domStatus.ChangeId,
+		"propagation_status":      domStatus.PropagationStatus,
+		"propagation_status_date": domStatus.PropagationStatusDate,
+		"message":                 domStatus.Message,
+	} {
+		if err := d.Set(stateKey, stateValue); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("GTM dataSourceGTMDomainLastChangeRead: setting %s failed.", stateKey),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	domainLastChangeID := fmt.Sprintf("%s:%s:%s", domain, "last_change", domStatus.ChangeId)
+	logger.Debugf("DataSourceGTMDomainLastChangeRead: generated Domain Last Change Resource Id: %s", domainLastChangeID)
+	d.SetId(domainLastChangeID)
+
+	return nil
+}
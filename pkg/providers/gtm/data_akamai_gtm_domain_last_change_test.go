@@ -0,0 +1,54 @@
+package gtm
+
+import (
+	"testing"
+
+	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDataSourceGTMDomainLastChange_basic(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		client := &mockgtm{}
+
+		dom := gtm.Domain{
+			Name:                 "testdomain.net",
+			LastModified:         "2021-04-25T14:53:12.000+00:00",
+			LastModifiedBy:       "terraform",
+			ModificationComments: "Edit Property test_property",
+		}
+
+		client.On("GetDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			"testdomain.net",
+		).Return(&dom, nil)
+
+		client.On("GetDomainStatus",
+			mock.Anything, // ctx is irrelevant for this test
+			"testdomain.net",
+		).Return(&completeResponseStatus, nil)
+
+		dataSourceName := "data.akamai_gtm_domain_last_change.test"
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDataDomainLastChange/basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+							resource.TestCheckResourceAttr(dataSourceName, "last_modified_by", "terraform"),
+							resource.TestCheckResourceAttr(dataSourceName, "modification_comments", "Edit Property test_property"),
+							resource.TestCheckResourceAttr(dataSourceName, "propagation_status", completeResponseStatus.PropagationStatus),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,182 @@
+package gtm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// domainTemplateOmittedFields are top level domain fields that are assigned by the API or
+// tied to the source domain, and therefore can't seed a new domain
+var domainTemplateOmittedFields = []string{
+	"name",
+	"status",
+	"lastModified",
+	"lastModifiedBy",
+	"modificationComments",
+}
+
+// domainTemplateSecretFields are credentials that must not end up in the template, at any level
+// of the domain object graph (e.g. liveness tests nested under properties)
+var domainTemplateSecretFields = []string{
+	"defaultSslClientPrivateKey",
+	"sslClientPrivateKey",
+	"testObjectPassword",
+}
+
+func dataSourceGTMDomainTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGTMDomainTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"load_imbalance_percentage": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"email_notification_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"datacenter_nicknames": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"property_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGTMDomainTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Akamai GTM", "dataSourceGTMDomainTemplateRead")
+
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	domain, err := tools.GetStringValue("domain", d)
+	if err != nil {
+		logger.Errorf("[Error] GTM dataSourceGTMDomainTemplateRead: Domain not initialized")
+		return diag.FromErr(err)
+	}
+	var diags diag.Diagnostics
+	logger.Debugf("Start Domain Template Retrieval for domain %s", domain)
+
+	dom, err := inst.Client(meta).GetDomain(ctx, domain)
+	if err != nil {
+		logger.Errorf("Domain Template Read failed: %s", err.Error())
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Domain Template Read error",
+			Detail:   err.Error(),
+		})
+	}
+
+	template, err := domainTemplateJSON(dom)
+	if err != nil {
+		logger.Errorf("Domain Template conversion failed: %s", err.Error())
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Domain Template conversion error",
+			Detail:   err.Error(),
+		})
+	}
+
+	datacenterNicknames := make([]string, 0, len(dom.Datacenters))
+	for _, dc := range dom.Datacenters {
+		datacenterNicknames = append(datacenterNicknames, dc.Nickname)
+	}
+	propertyNames := make([]string, 0, len(dom.Properties))
+	for _, prop := range dom.Properties {
+		propertyNames = append(propertyNames, prop.Name)
+	}
+
+	for stateKey, stateValue := range map[string]interface{}{
+		"type":                      dom.Type,
+		"load_imbalance_percentage": dom.LoadImbalancePercentage,
+		"email_notification_list":   dom.EmailNotificationList,
+		"datacenter_nicknames":      datacenterNicknames,
+		"property_names":            propertyNames,
+		"json":                      template,
+	} {
+		if err := d.Set(stateKey, stateValue); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("GTM dataSourceGTMDomainTemplateRead: setting %s failed.", stateKey),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	domainTemplateID := fmt.Sprintf("%s:%s", domain, "template")
+	logger.Debugf("DataSourceGTMDomainTemplateRead: generated Domain Template Resource Id: %s", domainTemplateID)
+	d.SetId(domainTemplateID)
+
+	return nil
+}
+
+// domainTemplateJSON serializes the domain object graph without the fields that only apply to the source domain
+func domainTemplateJSON(dom *gtm.Domain) (string, error) {
+	body, err := json.Marshal(dom)
+	if err != nil {
+		return "", err
+	}
+	var template map[string]interface{}
+	if err := json.Unmarshal(body, &template); err != nil {
+		return "", err
+	}
+	for _, field := range domainTemplateOmittedFields {
+		delete(template, field)
+	}
+	stripFields(template, append([]string{"links"}, domainTemplateSecretFields...)...)
+
+	body, err = json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// stripFields removes the given fields, such as API navigation links, at every level of a decoded JSON object
+func stripFields(v interface{}, fields ...string) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, field := range fields {
+			delete(val, field)
+		}
+		for _, child := range val {
+			stripFields(child, fields...)
+		}
+	case []interface{}:
+		for _, child := range val {
+			stripFields(child, fields...)
+		}
+	}
+}
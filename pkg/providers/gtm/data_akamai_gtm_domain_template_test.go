@@ -0,0 +1,121 @@
+package gtm
+
+import (
+	"encoding/json"
+	"testing"
+
+	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceGTMDomainTemplate_basic(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		client := &mockgtm{}
+
+		dom := gtm.Domain{
+			Name:                       "testdomain.net",
+			Type:                       "weighted",
+			LoadImbalancePercentage:    10,
+			EmailNotificationList:      []string{"ops@example.com"},
+			LastModifiedBy:             "terraform",
+			DefaultSslClientPrivateKey: "secret",
+			Status:                     &completeResponseStatus,
+			Links:                      []*gtm.Link{{Rel: "self", Href: "https://example.com/domain"}},
+			Datacenters: []*gtm.Datacenter{
+				{
+					DatacenterId: 3131,
+					Nickname:     "tfexample_dc_1",
+					Links:        []*gtm.Link{{Rel: "self", Href: "https://example.com/dc"}},
+				},
+			},
+			Properties: []*gtm.Property{
+				{
+					Name: "tfexample_prop_1",
+					Type: "weighted-round-robin",
+				},
+			},
+		}
+
+		client.On("GetDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			"testdomain.net",
+		).Return(&dom, nil)
+
+		dataSourceName := "data.akamai_gtm_domain_template.test"
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDataDomainTemplate/basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "id", "testdomain.net:template"),
+							resource.TestCheckResourceAttr(dataSourceName, "type", "weighted"),
+							resource.TestCheckResourceAttr(dataSourceName, "datacenter_nicknames.0", "tfexample_dc_1"),
+							resource.TestCheckResourceAttr(dataSourceName, "property_names.0", "tfexample_prop_1"),
+							func(s *terraform.State) error {
+								var template map[string]interface{}
+								rs := s.RootModule().Resources[dataSourceName]
+								require.NoError(t, json.Unmarshal([]byte(rs.Primary.Attributes["json"]), &template))
+								assert.NotContains(t, template, "name")
+								assert.NotContains(t, template, "status")
+								assert.NotContains(t, template, "links")
+								assert.NotContains(t, template, "lastModifiedBy")
+								assert.NotContains(t, template, "defaultSslClientPrivateKey")
+								assert.Equal(t, "weighted", template["type"])
+								dc := template["datacenters"].([]interface{})[0].(map[string]interface{})
+								assert.NotContains(t, dc, "links")
+								assert.Equal(t, "tfexample_dc_1", dc["nickname"])
+								return nil
+							},
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
+
+func TestDomainTemplateJSON(t *testing.T) {
+	dom := gtm.Domain{
+		Name:                       "testdomain.net",
+		Type:                       "weighted",
+		DefaultSslClientPrivateKey: "secret",
+		Properties: []*gtm.Property{
+			{
+				Name: "tfexample_prop_1",
+				LivenessTests: []*gtm.LivenessTest{
+					{
+						Name:                "lt1",
+						TestObjectUsername:  "user",
+						TestObjectPassword:  "password",
+						SslClientPrivateKey: "private key",
+					},
+				},
+			},
+		},
+	}
+
+	body, err := domainTemplateJSON(&dom)
+	require.NoError(t, err)
+	assert.NotContains(t, body, "secret")
+	assert.NotContains(t, body, "password")
+	assert.NotContains(t, body, "private key")
+
+	var template map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(body), &template))
+	prop := template["properties"].([]interface{})[0].(map[string]interface{})
+	lt := prop["livenessTests"].([]interface{})[0].(map[string]interface{})
+	assert.NotContains(t, lt, "testObjectPassword")
+	assert.NotContains(t, lt, "sslClientPrivateKey")
+	assert.Equal(t, "user", lt["testObjectUsername"])
+	assert.Equal(t, "lt1", lt["name"])
+}
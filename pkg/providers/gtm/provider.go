@@ -61,6 +61,9 @@ func Provider() *schema.Provider {
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"akamai_gtm_default_datacenter": dataSourceGTMDefaultDatacenter(),
+			"akamai_gtm_datacenters":        dataSourceGTMDatacenters(),
+			"akamai_gtm_domain_last_change": dataSourceGTMDomainLastChange(),
+			"akamai_gtm_domain_template":    dataSourceGTMDomainTemplate(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"akamai_gtm_domain":     resourceGTMv1Domain(),
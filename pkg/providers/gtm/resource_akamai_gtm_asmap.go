@@ -269,7 +269,15 @@ func resourceGTMv1ASmapUpdate(ctx context.Context, d *schema.ResourceData, m int
 	logger.Debugf("asMap BEFORE: %v", existAs)
 	populateASmapObject(d, existAs, m)
 	logger.Debugf("asMap PROPOSED: %v", existAs)
-	uStat, err := inst.Client(meta).UpdateAsMap(ctx, existAs, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateAsMap(ctx, existAs, domain)
+	}, func() error {
+		if existAs, err = inst.Client(meta).GetAsMap(ctx, asMap, domain); err != nil {
+			return err
+		}
+		populateASmapObject(d, existAs, m)
+		return nil
+	})
 	if err != nil {
 		logger.Errorf("asMap pdate: %s", err.Error())
 		return append(diags, diag.Diagnostic{
@@ -494,38 +502,42 @@ func populateTerraformAsAssignmentsState(d *schema.ResourceData, as *gtm.AsMap,
 			objectInventory[aObj.DatacenterId] = aObj
 		}
 	}
-	if aStateList, err := tools.GetInterfaceArrayValue("assignment", d); err != nil {
-		logger.Errorf("Assignment not set: %s", err.Error())
-	} else {
-		for _, aMap := range aStateList {
-			a := aMap.(map[string]interface{})
-			objIndex := a["datacenter_id"].(int)
-			aObject, ok := objectInventory[objIndex]
-			if !ok {
-				logger.Warnf("As Assignment %d NOT FOUND in returned GTM Object", a["datacenter_id"])
+	aStateList, err := tools.GetInterfaceArrayValue("assignment", d)
+	if err != nil {
+		// Nothing in the state yet, e.g. on import
+		logger.Debugf("Assignment not set: %s", err.Error())
+	}
+	for _, aMap := range aStateList {
+		a := aMap.(map[string]interface{})
+		objIndex := a["datacenter_id"].(int)
+		aObject, ok := objectInventory[objIndex]
+		if !ok {
+			logger.Warnf("As Assignment %d NOT FOUND in returned GTM Object", a["datacenter_id"])
+			continue
+		}
+		a["datacenter_id"] = aObject.DatacenterId
+		a["nickname"] = aObject.Nickname
+		a["as_numbers"] = reconcileTerraformLists(a["as_numbers"].([]interface{}), convertInt64ToInterfaceList(aObject.AsNumbers, m), m)
+		// remove object
+		delete(objectInventory, objIndex)
+	}
+	if len(objectInventory) > 0 {
+		logger.Debugf("As Assignment objects left...")
+		// Objects not in the state yet (e.g. on import). Add in the order returned by the API
+		for _, maObj := range as.Assignments {
+			if _, ok := objectInventory[maObj.DatacenterId]; !ok {
 				continue
 			}
-			a["datacenter_id"] = aObject.DatacenterId
-			a["nickname"] = aObject.Nickname
-			a["as_numbers"] = reconcileTerraformLists(a["as_numbers"].([]interface{}), convertInt64ToInterfaceList(aObject.AsNumbers, m), m)
-			// remove object
-			delete(objectInventory, objIndex)
-		}
-		if len(objectInventory) > 0 {
-			logger.Debugf("As Assignment objects left...")
-			// Objects not in the state yet. Add. Unfortunately, they not align with instance indices in the config
-			for _, maObj := range objectInventory {
-				aNew := map[string]interface{}{
-					"datacenter_id": maObj.DatacenterId,
-					"nickname":      maObj.Nickname,
-					"as_numbers":    maObj.AsNumbers,
-				}
-				aStateList = append(aStateList, aNew)
+			aNew := map[string]interface{}{
+				"datacenter_id": maObj.DatacenterId,
+				"nickname":      maObj.Nickname,
+				"as_numbers":    convertInt64ToInterfaceList(maObj.AsNumbers, m),
 			}
+			aStateList = append(aStateList, aNew)
 		}
-		if err := d.Set("assignment", aStateList); err != nil {
-			logger.Errorf("populateTerraformAsAssignmentsState failed: %s", err.Error())
-		}
+	}
+	if err := d.Set("assignment", aStateList); err != nil {
+		logger.Errorf("populateTerraformAsAssignmentsState failed: %s", err.Error())
 	}
 }
 
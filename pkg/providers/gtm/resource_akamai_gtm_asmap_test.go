@@ -1,12 +1,15 @@
 package gtm
 
 import (
+	"fmt"
 	"net/http"
 	"regexp"
 	"testing"
 
 	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -113,6 +116,25 @@ func TestResGtmAsmap(t *testing.T) {
 							resource.TestCheckResourceAttr(dataSourceName, "name", "tfexample_as_1"),
 						),
 					},
+					{
+						Config:        loadFixtureString("testdata/TestResGtmAsmap/update_basic.tf"),
+						ImportState:   true,
+						ImportStateId: fmt.Sprintf("%s:%s", gtmTestDomain, "tfexample_as_1"),
+						ResourceName:  dataSourceName,
+						ImportStateCheck: func(s []*terraform.InstanceState) error {
+							assert.Len(t, s, 1)
+							rs := s[0]
+							assert.Equal(t, gtmTestDomain, rs.Attributes["domain"])
+							assert.Equal(t, "tfexample_as_1", rs.Attributes["name"])
+							// the mocked map carries the assignments applied by the update step
+							assert.Equal(t, "2", rs.Attributes["assignment.#"])
+							assert.Equal(t, "3132", rs.Attributes["assignment.0.datacenter_id"])
+							assert.Equal(t, "tfexample_dc_2", rs.Attributes["assignment.0.nickname"])
+							assert.Equal(t, "3133", rs.Attributes["assignment.1.datacenter_id"])
+							assert.Equal(t, "3", rs.Attributes["assignment.1.as_numbers.#"])
+							return nil
+						},
+					},
 				},
 			})
 		})
@@ -26,6 +26,7 @@ func resourceGTMv1Cidrmap() *schema.Resource {
 			"domain": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"wait_on_complete": {
 				Type:     schema.TypeBool,
@@ -35,6 +36,7 @@ func resourceGTMv1Cidrmap() *schema.Resource {
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"default_datacenter": {
 				Type:     schema.TypeList,
@@ -222,10 +224,33 @@ func resourceGTMv1CidrMapUpdate(ctx context.Context, d *schema.ResourceData, m i
 			Detail:   err.Error(),
 		})
 	}
+	// Default datacenter may be switched in place; make sure the new one exists first
+	if d.HasChange("default_datacenter") {
+		defaultDatacenter, err := tools.GetInterfaceArrayValue("default_datacenter", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = validateDefaultDC(ctx, meta, defaultDatacenter, domain); err != nil {
+			logger.Errorf("Default datacenter validation error: %s", err.Error())
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Default datacenter validation error",
+				Detail:   err.Error(),
+			})
+		}
+	}
 	logger.Debugf("Updating cidrMap BEFORE: %v", existCidr)
 	populateCidrMapObject(d, existCidr, m)
 	logger.Debugf("Updating cidrMap PROPOSED: %v", existCidr)
-	uStat, err := inst.Client(meta).UpdateCidrMap(ctx, existCidr, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateCidrMap(ctx, existCidr, domain)
+	}, func() error {
+		if existCidr, err = inst.Client(meta).GetCidrMap(ctx, cidrMap, domain); err != nil {
+			return err
+		}
+		populateCidrMapObject(d, existCidr, m)
+		return nil
+	})
 	if err != nil {
 		logger.Errorf("cidrMap Update failed: %s", err.Error())
 		return append(diags, diag.Diagnostic{
@@ -290,9 +315,11 @@ func resourceGTMv1CidrMapImport(d *schema.ResourceData, m interface{}) ([]*schem
 	}
 	if err := d.Set("domain", domain); err != nil {
 		logger.Errorf("resourceGTMCidrMapImport failed: %s", err.Error())
+		return nil, err
 	}
 	if err := d.Set("wait_on_complete", true); err != nil {
 		logger.Errorf("resourceGTMCidrMapImport failed: %s", err.Error())
+		return nil, err
 	}
 	populateTerraformCidrMapState(d, cidr, m)
 
@@ -473,8 +500,11 @@ func populateTerraformCidrAssignmentsState(d *schema.ResourceData, cidr *gtm.Cid
 	}
 	if len(objectInventory) > 0 {
 		logger.Debugf("CIDR Assignment objects left...")
-		// Objects not in the state yet. Add. Unfortunately, they not align with instance indices in the config
-		for _, maObj := range objectInventory {
+		// Objects not in the state yet (e.g. on import). Add in the order returned by the API
+		for _, maObj := range cidr.Assignments {
+			if _, ok := objectInventory[maObj.DatacenterId]; !ok {
+				continue
+			}
 			aNew := map[string]interface{}{
 				"datacenter_id": maObj.DatacenterId,
 				"nickname":      maObj.Nickname,
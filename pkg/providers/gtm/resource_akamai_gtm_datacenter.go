@@ -102,14 +102,17 @@ func resourceGTMv1Datacenter() *schema.Resource {
 			},
 			"ping_interval": {
 				Type:     schema.TypeInt,
+				Optional: true,
 				Computed: true,
 			},
 			"ping_packet_size": {
 				Type:     schema.TypeInt,
+				Optional: true,
 				Computed: true,
 			},
 			"score_penalty": {
 				Type:     schema.TypeInt,
+				Optional: true,
 				Computed: true,
 			},
 			"servermonitor_liveness_count": {
@@ -311,7 +314,14 @@ func resourceGTMv1DatacenterUpdate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	logger.Debugf("Updating Datacenter PROPOSED: %v", existDC)
-	uStat, err := inst.Client(meta).UpdateDatacenter(ctx, existDC, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateDatacenter(ctx, existDC, domain)
+	}, func() error {
+		if existDC, err = inst.Client(meta).GetDatacenter(ctx, dcID, domain); err != nil {
+			return err
+		}
+		return populateDatacenterObject(d, existDC, m)
+	})
 	if err != nil {
 		logger.Errorf("Datacenter Update failed: %s", err.Error())
 		return append(diags, diag.Diagnostic{
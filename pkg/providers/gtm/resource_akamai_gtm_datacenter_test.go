@@ -102,6 +102,15 @@ func TestResGtmDatacenter(t *testing.T) {
 							resource.TestCheckResourceAttr(dataSourceName, "continent", "NA"),
 						),
 					},
+					{
+						Config: loadFixtureString("testdata/TestResGtmDatacenter/update_penalty.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "cloud_server_host_header_override", "true"),
+							resource.TestCheckResourceAttr(dataSourceName, "ping_interval", "30"),
+							resource.TestCheckResourceAttr(dataSourceName, "ping_packet_size", "25"),
+							resource.TestCheckResourceAttr(dataSourceName, "score_penalty", "10"),
+						),
+					},
 				},
 			})
 		})
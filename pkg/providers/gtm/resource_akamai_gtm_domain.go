@@ -14,6 +14,7 @@ import (
 	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
 
+	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -370,7 +371,14 @@ func resourceGTMv1DomainUpdate(ctx context.Context, d *schema.ResourceData, m in
 			Detail:   err.Error(),
 		})
 	}
-	uStat, err := inst.Client(meta).UpdateDomain(ctx, existDom, args)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateDomain(ctx, existDom, args)
+	}, func() error {
+		if existDom, err = inst.Client(meta).GetDomain(ctx, d.Id()); err != nil {
+			return err
+		}
+		return populateDomainObject(d, existDom, m)
+	})
 	if err != nil {
 		logger.Errorf("Domain Update failed: %s", err.Error())
 		return append(diags, diag.Diagnostic{
@@ -789,3 +797,41 @@ func waitForCompletion(ctx context.Context, domain string, m interface{}) (bool,
 		}
 	}
 }
+
+var (
+	// conflictRetryInterval is the delay before the first retry of a conflicting update; it doubles on each attempt
+	conflictRetryInterval = 2 * time.Second
+	// conflictRetryAttempts is the maximum number of times a conflicting update is retried
+	conflictRetryAttempts = 5
+)
+
+// Util function to retry a GTM update rejected because another change to the same domain is in flight.
+// refresh is called before each retry so the next attempt is built from the current domain state.
+func updateWithConflictRetry(ctx context.Context, logger log.Interface, update func() (*gtm.ResponseStatus, error), refresh func() error) (*gtm.ResponseStatus, error) {
+	interval := conflictRetryInterval
+	for attempt := 0; ; attempt++ {
+		uStat, err := update()
+		if err == nil || !isConflictError(err) || attempt >= conflictRetryAttempts {
+			return uStat, err
+		}
+		logger.Warnf("RETRY: update conflicted with a concurrent change, retrying in %v [%s]", interval, err.Error())
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil, err
+		}
+		interval *= 2
+		if err := refresh(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isConflictError reports whether err is a GTM API rejection caused by a concurrent domain modification
+func isConflictError(err error) bool {
+	var apiError *gtm.Error
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	return apiError.StatusCode == http.StatusConflict
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	gtm "github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/configgtm"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -279,6 +280,87 @@ func TestResGtmDomain(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("update domain retried on conflict", func(t *testing.T) {
+		client := &mockgtm{}
+
+		interval := conflictRetryInterval
+		conflictRetryInterval = time.Millisecond
+		defer func() { conflictRetryInterval = interval }()
+
+		getCall := client.On("GetDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			gtmTestDomain,
+		).Return(nil, &gtm.Error{
+			StatusCode: http.StatusNotFound,
+		})
+
+		dr := gtm.DomainResponse{}
+		dr.Resource = &dom
+		dr.Status = &pendingResponseStatus
+		client.On("CreateDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("*gtm.Domain"),
+			mock.AnythingOfType("map[string]string"),
+		).Return(&dr, nil).Run(func(args mock.Arguments) {
+			getCall.ReturnArguments = mock.Arguments{args.Get(1).(*gtm.Domain), nil}
+		})
+
+		client.On("NewDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+		).Return(&dom)
+
+		client.On("GetDomainStatus",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("string"),
+		).Return(&completeResponseStatus, nil)
+
+		client.On("UpdateDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("*gtm.Domain"),
+			mock.AnythingOfType("map[string]string"),
+		).Return(nil, &gtm.Error{
+			StatusCode: http.StatusConflict,
+		}).Once()
+
+		client.On("UpdateDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("*gtm.Domain"),
+			mock.AnythingOfType("map[string]string"),
+		).Return(&completeResponseStatus, nil).Run(func(args mock.Arguments) {
+			getCall.ReturnArguments = mock.Arguments{args.Get(1).(*gtm.Domain), nil}
+		})
+
+		client.On("DeleteDomain",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("*gtm.Domain"),
+		).Return(&completeResponseStatus, nil)
+
+		dataSourceName := "akamai_gtm_domain.testdomain"
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResGtmDomain/create_basic.tf"),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResGtmDomain/update_basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "load_imbalance_percentage", "20"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+		client.AssertNumberOfCalls(t, "UpdateDomain", 2)
+	})
+
 	t.Run("create domain failed", func(t *testing.T) {
 		client := &mockgtm{}
 
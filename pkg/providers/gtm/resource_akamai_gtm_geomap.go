@@ -230,7 +230,15 @@ func resourceGTMv1GeomapUpdate(ctx context.Context, d *schema.ResourceData, m in
 	logger.Debugf("Updating geoMap BEFORE: %v", existGeo)
 	populateGeoMapObject(d, existGeo, m)
 	logger.Debugf("Updating geoMap PROPOSED: %v", existGeo)
-	uStat, err := inst.Client(meta).UpdateGeoMap(ctx, existGeo, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateGeoMap(ctx, existGeo, domain)
+	}, func() error {
+		if existGeo, err = inst.Client(meta).GetGeoMap(ctx, geoMap, domain); err != nil {
+			return err
+		}
+		populateGeoMapObject(d, existGeo, m)
+		return nil
+	})
 	if err != nil {
 		logger.Errorf("geoMap Update failed: %s", err.Error())
 		return append(diags, diag.Diagnostic{
@@ -479,8 +487,11 @@ func populateTerraformGeoAssignmentsState(d *schema.ResourceData, geo *gtm.GeoMa
 	}
 	if len(objectInventory) > 0 {
 		logger.Debugf("Geo Assignment objects left...")
-		// Objects not in the state yet. Add. Unfortunately, they not align with instance indices in the config
-		for _, maObj := range objectInventory {
+		// Objects not in the state yet (e.g. on import). Add in the order returned by the API
+		for _, maObj := range geo.Assignments {
+			if _, ok := objectInventory[maObj.DatacenterId]; !ok {
+				continue
+			}
 			aNew := map[string]interface{}{
 				"datacenter_id": maObj.DatacenterId,
 				"nickname":      maObj.Nickname,
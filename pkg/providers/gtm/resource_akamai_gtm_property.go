@@ -13,6 +13,7 @@ import (
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGTMv1Property() *schema.Resource {
@@ -21,6 +22,7 @@ func resourceGTMv1Property() *schema.Resource {
 		ReadContext:   resourceGTMv1PropertyRead,
 		UpdateContext: resourceGTMv1PropertyUpdate,
 		DeleteContext: resourceGTMv1PropertyDelete,
+		CustomizeDiff: trafficTargetCustomDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceGTMv1PropertyImport,
 		},
@@ -51,12 +53,14 @@ func resourceGTMv1Property() *schema.Resource {
 				Required: true,
 			},
 			"stickiness_bonus_percentage": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
 			},
 			"stickiness_bonus_constant": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"health_threshold": {
 				Type:     schema.TypeFloat,
@@ -128,12 +132,20 @@ func resourceGTMv1Property() *schema.Resource {
 				Optional: true,
 			},
 			"handout_limit": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"handout_mode": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"normal",
+					"persistent",
+					"one-ip",
+					"one-ip-hashed",
+					"all-live-ips",
+				}, false),
 			},
 			"failover_delay": {
 				Type:     schema.TypeInt,
@@ -363,6 +375,67 @@ func validateTTL(v interface{}, key string) (ws []string, es []error) {
 	return
 }
 
+// trafficTargetCustomDiff validates the traffic targets of weighted properties at plan time: enabled targets
+// must carry a positive weight and every referenced datacenter must exist in the property domain.
+func trafficTargetCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Akamai GTM", "trafficTargetCustomDiff")
+
+	if d.Id() != "" && !d.HasChange("traffic_target") && !d.HasChange("type") {
+		return nil
+	}
+	propertyType, ok := d.Get("type").(string)
+	if !ok || !strings.HasPrefix(strings.ToLower(propertyType), "weighted") {
+		return nil
+	}
+	traffTargList, ok := d.Get("traffic_target").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	dcIDs := make([]int, 0, len(traffTargList))
+	for i, v := range traffTargList {
+		ttMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if enabled, _ := ttMap["enabled"].(bool); enabled && d.NewValueKnown(fmt.Sprintf("traffic_target.%d.weight", i)) {
+			if weight, _ := ttMap["weight"].(float64); weight <= 0 {
+				return fmt.Errorf("traffic_target for datacenter %v must have a positive weight in a %s property", ttMap["datacenter_id"], propertyType)
+			}
+		}
+		if d.NewValueKnown(fmt.Sprintf("traffic_target.%d.datacenter_id", i)) {
+			if dcID, _ := ttMap["datacenter_id"].(int); dcID != 0 {
+				dcIDs = append(dcIDs, dcID)
+			}
+		}
+	}
+	if len(dcIDs) == 0 || !d.NewValueKnown("domain") {
+		return nil
+	}
+
+	domain, ok := d.Get("domain").(string)
+	if !ok || domain == "" {
+		return nil
+	}
+	dcList, err := inst.Client(meta).ListDatacenters(ctx, domain)
+	if err != nil {
+		// domain may not exist until apply; leave the check to the GTM API
+		logger.Warnf("Unable to list datacenters of domain %s for traffic target validation: %s", domain, err.Error())
+		return nil
+	}
+	existing := make(map[int]bool, len(dcList))
+	for _, dc := range dcList {
+		existing[dc.DatacenterId] = true
+	}
+	for _, dcID := range dcIDs {
+		if !existing[dcID] {
+			return fmt.Errorf("traffic_target datacenter %d does not exist in domain %s", dcID, domain)
+		}
+	}
+	return nil
+}
+
 // Create a new GTM Property
 func resourceGTMv1PropertyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
@@ -498,7 +571,14 @@ func resourceGTMv1PropertyUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	logger.Debugf("Updating Property PROPOSED: %v", existProp)
-	uStat, err := inst.Client(meta).UpdateProperty(ctx, existProp, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateProperty(ctx, existProp, domain)
+	}, func() error {
+		if existProp, err = inst.Client(meta).GetProperty(ctx, property, domain); err != nil {
+			return err
+		}
+		return populatePropertyObject(ctx, d, existProp, m)
+	})
 	if err != nil {
 		logger.Errorf("Property Update failed: %s", err.Error())
 		return diag.FromErr(fmt.Errorf("Property Update failed: %s", err.Error()))
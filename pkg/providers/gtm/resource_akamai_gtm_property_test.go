@@ -77,11 +77,21 @@ var prop = gtm.Property{
 	UseComputedTargets:   false,
 }
 
+var propDatacenters = []*gtm.Datacenter{
+	{DatacenterId: 3131},
+	{DatacenterId: 3132},
+}
+
 func TestResGtmProperty(t *testing.T) {
 
 	t.Run("create property", func(t *testing.T) {
 		client := &mockgtm{}
 
+		client.On("ListDatacenters",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("string"),
+		).Return(propDatacenters, nil)
+
 		getCall := client.On("GetProperty",
 			mock.Anything, // ctx is irrelevant for this test
 			mock.AnythingOfType("string"),
@@ -176,6 +186,20 @@ func TestResGtmProperty(t *testing.T) {
 							resource.TestCheckResourceAttr(dataSourceName, "type", "weighted-round-robin"),
 						),
 					},
+					{
+						Config: loadFixtureString("testdata/TestResGtmProperty/update_drain.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "name", "tfexample_prop_1"),
+							resource.TestCheckResourceAttr(dataSourceName, "traffic_target.0.enabled", "false"),
+							resource.TestCheckResourceAttr(dataSourceName, "traffic_target.0.weight", "200"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResGtmProperty/update_basic.tf"),
+						Check: resource.ComposeTestCheckFunc(
+							resource.TestCheckResourceAttr(dataSourceName, "traffic_target.0.enabled", "true"),
+						),
+					},
 				},
 			})
 		})
@@ -186,6 +210,11 @@ func TestResGtmProperty(t *testing.T) {
 	t.Run("create property failed", func(t *testing.T) {
 		client := &mockgtm{}
 
+		client.On("ListDatacenters",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("string"),
+		).Return(propDatacenters, nil)
+
 		client.On("CreateProperty",
 			mock.Anything, // ctx is irrelevant for this test
 			mock.AnythingOfType("*gtm.Property"),
@@ -248,6 +277,11 @@ func TestResGtmProperty(t *testing.T) {
 	t.Run("create property denied", func(t *testing.T) {
 		client := &mockgtm{}
 
+		client.On("ListDatacenters",
+			mock.Anything, // ctx is irrelevant for this test
+			mock.AnythingOfType("string"),
+		).Return(propDatacenters, nil)
+
 		dr := gtm.PropertyResponse{}
 		dr.Resource = &prop
 		dr.Status = &deniedResponseStatus
@@ -307,4 +341,66 @@ func TestResGtmProperty(t *testing.T) {
 
 		client.AssertExpectations(t)
 	})
+
+	t.Run("create property with non-positive weight", func(t *testing.T) {
+		client := &mockgtm{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResGtmProperty/create_zero_weight.tf"),
+						ExpectError: regexp.MustCompile("must have a positive weight"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create property with unknown datacenter", func(t *testing.T) {
+		client := &mockgtm{}
+
+		client.On("ListDatacenters",
+			mock.Anything, // ctx is irrelevant for this test
+			gtmTestDomain,
+		).Return([]*gtm.Datacenter{{DatacenterId: 3132}}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResGtmProperty/create_basic.tf"),
+						ExpectError: regexp.MustCompile("datacenter 3131 does not exist in domain"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create property with invalid handout mode", func(t *testing.T) {
+		client := &mockgtm{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResGtmProperty/create_invalid_handout_mode.tf"),
+						ExpectError: regexp.MustCompile(`expected handout_mode to be one of`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
 }
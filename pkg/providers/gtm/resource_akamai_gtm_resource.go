@@ -12,6 +12,7 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceGTMv1Resource() *schema.Resource {
@@ -20,6 +21,7 @@ func resourceGTMv1Resource() *schema.Resource {
 		ReadContext:   resourceGTMv1ResourceRead,
 		UpdateContext: resourceGTMv1ResourceUpdate,
 		DeleteContext: resourceGTMv1ResourceDelete,
+		CustomizeDiff: resourceInstanceLoadObjectCustomDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceGTMv1ResourceImport,
 		},
@@ -50,12 +52,14 @@ func resourceGTMv1Resource() *schema.Resource {
 				Required: true,
 			},
 			"least_squares_decay": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
 			},
 			"upper_bound": {
-				Type:     schema.TypeInt,
-				Optional: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -70,16 +74,19 @@ func resourceGTMv1Resource() *schema.Resource {
 				Optional: true,
 			},
 			"load_imbalance_percentage": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
 			},
 			"max_u_multiplicative_increment": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
 			},
 			"decay_rate": {
-				Type:     schema.TypeFloat,
-				Optional: true,
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
 			},
 			"resource_instance": {
 				Type:     schema.TypeList,
@@ -104,8 +111,9 @@ func resourceGTMv1Resource() *schema.Resource {
 							Optional: true,
 						},
 						"load_object_port": {
-							Type:     schema.TypeInt,
-							Optional: true,
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
 						},
 					},
 				},
@@ -114,6 +122,35 @@ func resourceGTMv1Resource() *schema.Resource {
 	}
 }
 
+// resourceInstanceLoadObjectCustomDiff verifies that every resource_instance not relying on the datacenter
+// default load object provides its own load_object, so load feedback can be collected for the instance.
+func resourceInstanceLoadObjectCustomDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("Akamai GTM", "resourceInstanceLoadObjectCustomDiff")
+
+	instances, ok := d.Get("resource_instance").([]interface{})
+	if !ok {
+		return nil
+	}
+	for i, v := range instances {
+		riMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if useDefault, _ := riMap["use_default_load_object"].(bool); useDefault {
+			continue
+		}
+		if !d.NewValueKnown(fmt.Sprintf("resource_instance.%d.load_object", i)) {
+			continue
+		}
+		if loadObject, _ := riMap["load_object"].(string); loadObject == "" {
+			logger.Errorf("resource_instance for datacenter %v has no load_object", riMap["datacenter_id"])
+			return fmt.Errorf("resource_instance for datacenter %v must set load_object when use_default_load_object is false", riMap["datacenter_id"])
+		}
+	}
+	return nil
+}
+
 // Create a new GTM Resource
 func resourceGTMv1ResourceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
@@ -254,7 +291,14 @@ func resourceGTMv1ResourceUpdate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	logger.Debugf("Updating Resource PROPOSED: %v", existRsrc)
-	uStat, err := inst.Client(meta).UpdateResource(ctx, existRsrc, domain)
+	uStat, err := updateWithConflictRetry(ctx, logger, func() (*gtm.ResponseStatus, error) {
+		return inst.Client(meta).UpdateResource(ctx, existRsrc, domain)
+	}, func() error {
+		if existRsrc, err = inst.Client(meta).GetResource(ctx, resource, domain); err != nil {
+			return err
+		}
+		return populateResourceObject(ctx, d, existRsrc, m)
+	})
 	if err != nil {
 		logger.Errorf("Resource Update failed: %s", err.Error())
 		return append(diags, diag.Diagnostic{
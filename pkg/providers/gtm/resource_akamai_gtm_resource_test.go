@@ -233,4 +233,42 @@ func TestResGtmResource(t *testing.T) {
 
 		client.AssertExpectations(t)
 	})
+
+	t.Run("create resource without load object", func(t *testing.T) {
+		client := &mockgtm{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResGtmResource/create_missing_load_object.tf"),
+						ExpectError: regexp.MustCompile("must set load_object when use_default_load_object is false"),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("create resource with negative decay rate", func(t *testing.T) {
+		client := &mockgtm{}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				PreCheck:  func() { testAccPreCheck(t) },
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestResGtmResource/create_invalid_decay_rate.tf"),
+						ExpectError: regexp.MustCompile(`expected decay_rate to be at least \(0\.0+\)`),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_gtm_datacenters" "test" {
+	domain = "testdomain.net"
+}
+
+output "datacenter_ids" {
+	value = data.akamai_gtm_datacenters.test.datacenters[*].datacenter_id
+}
@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_gtm_domain_last_change" "test" {
+	domain = "testdomain.net"
+}
+
+output "last_modified_by" {
+	value = data.akamai_gtm_domain_last_change.test.last_modified_by
+}
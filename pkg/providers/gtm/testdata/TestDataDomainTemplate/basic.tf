@@ -0,0 +1,11 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_gtm_domain_template" "test" {
+	domain = "testdomain.net"
+}
+
+output "template" {
+	value = jsondecode(data.akamai_gtm_domain_template.test.json)
+}
@@ -0,0 +1,24 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+locals {
+  gtmTestDomain = "gtm_terra_testdomain.akadns.net"
+}
+
+resource "akamai_gtm_datacenter" "tfexample_dc_1" {
+  domain = local.gtmTestDomain
+  nickname         = "tfexample_dc_1"
+  wait_on_complete = true 
+  default_load_object {
+    load_object      = "/test"
+    load_object_port = 80
+    load_servers     = ["1.2.3.5", "1.2.3.6"]
+  }
+  continent = "NA"
+  cloud_server_targeting            = true
+  cloud_server_host_header_override = true
+  ping_interval                     = 30
+  ping_packet_size                  = 25
+  score_penalty                     = 10
+}
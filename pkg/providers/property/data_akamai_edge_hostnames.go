@@ -0,0 +1,103 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourceAkamaiEdgeHostnames() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataAkamaiEdgeHostnamesRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"edge_hostnames": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of edge hostnames",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"edge_hostname_id": {Type: schema.TypeString, Computed: true},
+						"edge_hostname":    {Type: schema.TypeString, Computed: true},
+						"product_id":       {Type: schema.TypeString, Computed: true},
+						"domain_prefix":    {Type: schema.TypeString, Computed: true},
+						"domain_suffix":    {Type: schema.TypeString, Computed: true},
+						"ip_behavior":      {Type: schema.TypeString, Computed: true},
+						"secure":           {Type: schema.TypeBool, Computed: true},
+						"status":           {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataAkamaiEdgeHostnamesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	log := meta.Log("PAPI", "dataAkamaiEdgeHostnamesRead")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(log),
+	)
+	log.Debug("Listing edge hostnames")
+
+	groupID, err := tools.GetStringValue("group_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groupID = tools.AddPrefix(groupID, "grp_")
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = tools.AddPrefix(contractID, "ctr_")
+
+	edgeHostnamesResponse, err := client.GetEdgeHostnames(ctx, papi.GetEdgeHostnamesRequest{
+		ContractID: contractID,
+		GroupID:    groupID,
+	})
+	if err != nil {
+		log.WithError(err).Error("could not fetch edge hostnames")
+		return diag.FromErr(err)
+	}
+
+	edgeHostnames := make([]map[string]interface{}, 0, len(edgeHostnamesResponse.EdgeHostnames.Items))
+	for _, ehn := range edgeHostnamesResponse.EdgeHostnames.Items {
+		edgeHostnames = append(edgeHostnames, map[string]interface{}{
+			"edge_hostname_id": ehn.ID,
+			"edge_hostname":    ehn.Domain,
+			"product_id":       ehn.ProductID,
+			"domain_prefix":    ehn.DomainPrefix,
+			"domain_suffix":    ehn.DomainSuffix,
+			"ip_behavior":      ehn.IPVersionBehavior,
+			"secure":           ehn.Secure,
+			"status":           ehn.Status,
+		})
+	}
+
+	if err := d.Set("edge_hostnames", edgeHostnames); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", groupID, contractID))
+
+	return nil
+}
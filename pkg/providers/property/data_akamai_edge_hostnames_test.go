@@ -0,0 +1,64 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestDataEdgeHostnames(t *testing.T) {
+	t.Run("list edge hostnames", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetEdgeHostnames", mock.Anything, papi.GetEdgeHostnamesRequest{
+			ContractID: "ctr_test",
+			GroupID:    "grp_test",
+		}).Return(&papi.GetEdgeHostnamesResponse{
+			ContractID: "ctr_test",
+			GroupID:    "grp_test",
+			EdgeHostnames: papi.EdgeHostnameItems{Items: []papi.EdgeHostnameGetItem{
+				{
+					ID:                "ehn_1",
+					Domain:            "www.example.com.edgesuite.net",
+					ProductID:         "prd_test",
+					DomainPrefix:      "www.example.com",
+					DomainSuffix:      "edgesuite.net",
+					IPVersionBehavior: "IPV4",
+					Status:            "ACTIVE",
+				},
+				{
+					ID:                "ehn_2",
+					Domain:            "www.example.com.edgekey.net",
+					ProductID:         "prd_test",
+					DomainPrefix:      "www.example.com",
+					DomainSuffix:      "edgekey.net",
+					IPVersionBehavior: "IPV6_COMPLIANCE",
+					Secure:            true,
+					Status:            "ACTIVE",
+				},
+			}},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataEdgeHostnames/edge_hostnames.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "id", "grp_test:ctr_test"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.0.edge_hostname_id", "ehn_1"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.0.secure", "false"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.1.edge_hostname", "www.example.com.edgekey.net"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.1.ip_behavior", "IPV6_COMPLIANCE"),
+						resource.TestCheckResourceAttr("data.akamai_edge_hostnames.ehns", "edge_hostnames.1.secure", "true"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -2,9 +2,13 @@ package property
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
@@ -18,13 +22,28 @@ func dataSourceAkamaiProperties() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ValidateDiagFunc: tools.IsNotBlank,
+				RequiredWith:     []string{"contract_id"},
+				AtLeastOneOf:     []string{"group_id", "hostname"},
 			},
 			"contract_id": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ValidateDiagFunc: tools.IsNotBlank,
+				RequiredWith:     []string{"group_id"},
+			},
+			"hostname": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+				Description:      "Return only the properties which serve the given hostname",
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Return only the properties whose name matches the given regular expression",
 			},
 			"properties": {
 				Type:        schema.TypeList,
@@ -61,24 +80,59 @@ func dataAkamaiPropertiesRead(ctx context.Context, d *schema.ResourceData, m int
 
 	// groupID / contractID is string as per schema.
 	groupID, err := tools.GetStringValue("group_id", d)
-	if err != nil {
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
-	groupID = tools.AddPrefix(groupID, "grp_")
+	if groupID != "" {
+		groupID = tools.AddPrefix(groupID, "grp_")
+	}
 	contractID, err := tools.GetStringValue("contract_id", d)
-	if err != nil {
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if contractID != "" {
+		contractID = tools.AddPrefix(contractID, "ctr_")
+	}
+	hostname, err := tools.GetStringValue("hostname", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	nameRegex, err := tools.GetStringValue("name_regex", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
-	contractID = tools.AddPrefix(contractID, "ctr_")
 
-	propertiesResponse, err := getProperties(ctx, groupID, contractID, meta)
+	var propertiesResponse *papi.GetPropertiesResponse
+	if hostname != "" {
+		propertiesResponse, err = getPropertiesByHostname(ctx, hostname, groupID, contractID, meta)
+	} else {
+		propertiesResponse, err = getProperties(ctx, groupID, contractID, meta)
+	}
 	if err != nil {
 		return diag.Errorf("error listing properties: %v", err)
 	}
-	contractID = tools.AddPrefix(contractID, "ctr_")
+
+	if nameRegex != "" {
+		// regular expression was already checked by schema validation
+		re := regexp.MustCompile(nameRegex)
+		filtered := make([]*papi.Property, 0, len(propertiesResponse.Properties.Items))
+		for _, item := range propertiesResponse.Properties.Items {
+			if re.MatchString(item.PropertyName) {
+				filtered = append(filtered, item)
+			}
+		}
+		propertiesResponse.Properties.Items = filtered
+	}
 
 	// setting concatenated id to uniquely identify data
-	d.SetId(groupID + contractID)
+	id := groupID + contractID
+	if hostname != "" {
+		id = fmt.Sprintf("%s:%s", id, hostname)
+	}
+	if nameRegex != "" {
+		id = fmt.Sprintf("%s:%s", id, nameRegex)
+	}
+	d.SetId(id)
 
 	if err := d.Set("properties", sliceResponseProperties(propertiesResponse)); err != nil {
 		return diag.Errorf("error setting properties: %s", err)
@@ -128,3 +182,37 @@ func getProperties(ctx context.Context, groupID string, contractID string, meta
 	}
 	return props, nil
 }
+
+// getPropertiesByHostname fetches the properties which serve the given hostname, optionally narrowed down to
+// a group and contract
+func getPropertiesByHostname(ctx context.Context, hostname, groupID, contractID string, meta akamai.OperationMeta) (*papi.GetPropertiesResponse, error) {
+	client := inst.Client(meta)
+	results, err := client.SearchProperties(ctx, papi.SearchRequest{Key: papi.SearchKeyHostname, Value: hostname})
+	if err != nil {
+		return nil, err
+	}
+
+	props := &papi.GetPropertiesResponse{Properties: papi.PropertiesItems{Items: []*papi.Property{}}}
+	// search returns one item per matching version, so the same property may be listed more than once
+	seen := make(map[string]bool)
+	for _, item := range results.Versions.Items {
+		if seen[item.PropertyID] {
+			continue
+		}
+		if (groupID != "" && item.GroupID != groupID) || (contractID != "" && item.ContractID != contractID) {
+			continue
+		}
+		seen[item.PropertyID] = true
+
+		property, err := client.GetProperty(ctx, papi.GetPropertyRequest{
+			ContractID: item.ContractID,
+			GroupID:    item.GroupID,
+			PropertyID: item.PropertyID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		props.Properties.Items = append(props.Properties.Items, property.Properties.Items...)
+	}
+	return props, nil
+}
@@ -77,6 +77,62 @@ func TestDataProperties(t *testing.T) {
 			})
 		})
 
+		client.AssertExpectations(t)
+	})
+	t.Run("list properties filtered by name", func(t *testing.T) {
+		client := &mockpapi{}
+		props := papi.PropertiesItems{Items: buildPapiProperties()}
+		properties := decodePropertyItems(props.Items[:5])
+
+		client.On("GetProperties",
+			mock.Anything,
+			papi.GetPropertiesRequest{GroupID: "grp_test", ContractID: "ctr_test"},
+		).Return(&papi.GetPropertiesResponse{Properties: props}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataProperties/properties_name_regex.tf"),
+					Check:  buildAggregatedTest(properties, "grp_testctr_test:^prpname[0-4]$", "grp_test", "ctr_test"),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("list properties serving hostname", func(t *testing.T) {
+		client := &mockpapi{}
+		papiProperties := buildPapiProperties()
+		properties := decodePropertyItems(papiProperties[:2])
+
+		client.On("SearchProperties",
+			mock.Anything,
+			papi.SearchRequest{Key: papi.SearchKeyHostname, Value: "www.example.com"},
+		).Return(&papi.SearchResponse{Versions: papi.SearchItems{Items: []papi.SearchItem{
+			{ContractID: "ctr_test", GroupID: "grp_test", PropertyID: "prp0", PropertyVersion: 1, StagingStatus: "ACTIVE"},
+			{ContractID: "ctr_test", GroupID: "grp_test", PropertyID: "prp0", PropertyVersion: 2, ProductionStatus: "ACTIVE"},
+			{ContractID: "ctr_test", GroupID: "grp_test", PropertyID: "prp1", PropertyVersion: 1, ProductionStatus: "ACTIVE"},
+			{ContractID: "ctr_test", GroupID: "grp_test", PropertyID: "prp2", PropertyVersion: 1, StagingStatus: "ACTIVE"},
+		}}}, nil)
+		for i := 0; i < 3; i++ {
+			client.On("GetProperty",
+				mock.Anything,
+				papi.GetPropertyRequest{ContractID: "ctr_test", GroupID: "grp_test", PropertyID: fmt.Sprintf("prp%v", i)},
+			).Return(&papi.GetPropertyResponse{Properties: papi.PropertiesItems{Items: []*papi.Property{papiProperties[i]}}}, nil)
+		}
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataProperties/properties_by_hostname.tf"),
+					Check:  buildAggregatedTest(properties, ":www.example.com:^prpname[0-1]$", "", ""),
+				}},
+			})
+		})
+
 		client.AssertExpectations(t)
 	})
 }
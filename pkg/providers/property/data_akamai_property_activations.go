@@ -0,0 +1,130 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourceAkamaiPropertyActivations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataAkamaiPropertyActivationsRead,
+		Schema: map[string]*schema.Schema{
+			"property_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        addPrefixToState("prp_"),
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"contract_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"group_id"},
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"contract_id"},
+			},
+			"network": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(papi.ActivationNetworkStaging), string(papi.ActivationNetworkProduction)}, false),
+				Description:  "Only list the activations on this network",
+			},
+			"activations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of activations, as returned by PAPI",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"activation_id":   {Type: schema.TypeString, Computed: true},
+						"version":         {Type: schema.TypeInt, Computed: true},
+						"network":         {Type: schema.TypeString, Computed: true},
+						"activation_type": {Type: schema.TypeString, Computed: true},
+						"status":          {Type: schema.TypeString, Computed: true},
+						"note":            {Type: schema.TypeString, Computed: true},
+						"notify_emails": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"submit_date": {Type: schema.TypeString, Computed: true},
+						"update_date": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataAkamaiPropertyActivationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	log := meta.Log("PAPI", "dataAkamaiPropertyActivationsRead")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(log),
+	)
+	log.Debug("Listing property activations")
+
+	propertyID, err := tools.GetStringValue("property_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	propertyID = tools.AddPrefix(propertyID, "prp_")
+
+	req := papi.GetActivationsRequest{PropertyID: propertyID}
+	if contractID, ok := d.GetOk("contract_id"); ok {
+		req.ContractID = tools.AddPrefix(contractID.(string), "ctr_")
+	}
+	if groupID, ok := d.GetOk("group_id"); ok {
+		req.GroupID = tools.AddPrefix(groupID.(string), "grp_")
+	}
+	network := d.Get("network").(string)
+
+	res, err := client.GetActivations(ctx, req)
+	if err != nil {
+		log.WithError(err).Error("could not fetch property activations")
+		return diag.FromErr(err)
+	}
+
+	activations := make([]map[string]interface{}, 0, len(res.Activations.Items))
+	for _, act := range res.Activations.Items {
+		if network != "" && string(act.Network) != network {
+			continue
+		}
+		activations = append(activations, map[string]interface{}{
+			"activation_id":   act.ActivationID,
+			"version":         act.PropertyVersion,
+			"network":         string(act.Network),
+			"activation_type": string(act.ActivationType),
+			"status":          string(act.Status),
+			"note":            act.Note,
+			"notify_emails":   act.NotifyEmails,
+			"submit_date":     act.SubmitDate,
+			"update_date":     act.UpdateDate,
+		})
+	}
+
+	if err := d.Set("activations", activations); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	id := propertyID
+	if network != "" {
+		id = fmt.Sprintf("%s:%s", propertyID, network)
+	}
+	d.SetId(id)
+
+	return nil
+}
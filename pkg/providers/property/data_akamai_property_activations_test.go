@@ -0,0 +1,70 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestDataPropertyActivations(t *testing.T) {
+	t.Run("list production activations", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetActivations", mock.Anything, papi.GetActivationsRequest{
+			PropertyID: "prp_test",
+			ContractID: "ctr_test",
+			GroupID:    "grp_test",
+		}).Return(&papi.GetActivationsResponse{
+			Activations: papi.ActivationsItems{Items: []*papi.Activation{
+				{
+					ActivationID:    "atv_3",
+					PropertyVersion: 3,
+					Network:         papi.ActivationNetworkProduction,
+					ActivationType:  papi.ActivationTypeActivate,
+					Status:          papi.ActivationStatusActive,
+					Note:            "release 1.2",
+					NotifyEmails:    []string{"ops@example.com"},
+					SubmitDate:      "2021-03-02T10:00:00Z",
+					UpdateDate:      "2021-03-02T10:20:00Z",
+				},
+				{
+					ActivationID:    "atv_2",
+					PropertyVersion: 3,
+					Network:         papi.ActivationNetworkStaging,
+					ActivationType:  papi.ActivationTypeActivate,
+					Status:          papi.ActivationStatusActive,
+				},
+				{
+					ActivationID:    "atv_1",
+					PropertyVersion: 2,
+					Network:         papi.ActivationNetworkProduction,
+					ActivationType:  papi.ActivationTypeActivate,
+					Status:          papi.ActivationStatusInactive,
+				},
+			}},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataPropertyActivations/property_activations.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "id", "prp_test:PRODUCTION"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.0.activation_id", "atv_3"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.0.version", "3"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.0.status", "ACTIVE"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.0.note", "release 1.2"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.0.notify_emails.0", "ops@example.com"),
+						resource.TestCheckResourceAttr("data.akamai_property_activations.activations", "activations.1.activation_id", "atv_1"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
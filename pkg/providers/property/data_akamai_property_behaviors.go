@@ -0,0 +1,151 @@
+package property
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourceAkamaiPropertyBehaviors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataAkamaiPropertyBehaviorsRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"contract_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"property_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				StateFunc:        addPrefixToState("prp_"),
+				ValidateDiagFunc: tools.IsNotBlank,
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Property version to list the behaviors for. The latest version is used if not provided",
+			},
+			"product_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Product the property version is based on",
+			},
+			"rule_format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Rule format of the property version",
+			},
+			"behaviors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of behaviors available for the product and rule format",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":        {Type: schema.TypeString, Computed: true},
+						"schema_link": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataAkamaiPropertyBehaviorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	client := inst.Client(meta)
+	log := meta.Log("PAPI", "dataAkamaiPropertyBehaviorsRead")
+	// create a context with logging for api calls
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(log),
+	)
+	log.Debug("Listing available property behaviors")
+
+	groupID, err := tools.GetStringValue("group_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groupID = tools.AddPrefix(groupID, "grp_")
+	contractID, err := tools.GetStringValue("contract_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	contractID = tools.AddPrefix(contractID, "ctr_")
+
+	propertyID, err := tools.GetStringValue("property_id", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	propertyID = tools.AddPrefix(propertyID, "prp_")
+
+	version, err := tools.GetIntValue("version", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if version == 0 {
+		latestVersion, err := client.GetLatestVersion(ctx, papi.GetLatestVersionRequest{
+			PropertyID: propertyID,
+			ContractID: contractID,
+			GroupID:    groupID,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		version = latestVersion.Version.PropertyVersion
+		contractID = latestVersion.ContractID
+		groupID = latestVersion.GroupID
+	}
+
+	log.Debug("fetching available behaviors")
+	behaviorsResponse, err := client.GetAvailableBehaviors(ctx, papi.GetFeaturesRequest{
+		PropertyID:      propertyID,
+		PropertyVersion: version,
+		ContractID:      contractID,
+		GroupID:         groupID,
+	})
+	if err != nil {
+		log.WithError(err).Error("could not fetch available behaviors")
+		return diag.FromErr(err)
+	}
+
+	behaviors := make([]map[string]interface{}, 0, len(behaviorsResponse.AvailableBehaviors.Items))
+	for _, behavior := range behaviorsResponse.AvailableBehaviors.Items {
+		behaviors = append(behaviors, map[string]interface{}{
+			"name":        behavior.Name,
+			"schema_link": behavior.SchemaLink,
+		})
+	}
+
+	attrs := map[string]interface{}{
+		"version":     version,
+		"product_id":  behaviorsResponse.ProductID,
+		"rule_format": behaviorsResponse.RuleFormat,
+		"behaviors":   behaviors,
+	}
+	if err := tools.SetAttrs(d, attrs); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	d.SetId(propertyID + strconv.Itoa(version))
+
+	return nil
+}
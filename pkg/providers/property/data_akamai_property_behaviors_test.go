@@ -0,0 +1,68 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestDataPropertyBehaviors(t *testing.T) {
+	t.Run("list behaviors for property version", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetAvailableBehaviors", mock.Anything, papi.GetFeaturesRequest{
+			PropertyID:      "prp_test",
+			PropertyVersion: 2,
+			ContractID:      "ctr_test",
+			GroupID:         "grp_test",
+		}).Return(&papi.GetFeaturesCriteriaResponse{
+			ContractID: "ctr_test",
+			GroupID:    "grp_test",
+			ProductID:  "prd_Web_App_Accel",
+			RuleFormat: "v2020-03-04",
+			AvailableBehaviors: papi.AvailableFeatureItems{Items: []papi.AvailableFeature{
+				{Name: "origin", SchemaLink: "/papi/v0/schemas/products/prd_Web_App_Accel/latest#/definitions/catalog/behaviors/origin"},
+				{Name: "caching", SchemaLink: "/papi/v0/schemas/products/prd_Web_App_Accel/latest#/definitions/catalog/behaviors/caching"},
+			}},
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataPropertyBehaviors/property_behaviors.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "id", "prp_test2"),
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "product_id", "prd_Web_App_Accel"),
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "rule_format", "v2020-03-04"),
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "behaviors.#", "2"),
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "behaviors.0.name", "origin"),
+						resource.TestCheckResourceAttr("data.akamai_property_behaviors.behaviors", "behaviors.1.name", "caching"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error fetching behaviors", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetAvailableBehaviors", mock.Anything, mock.Anything).Return(nil, papi.ErrGetAvailableBehaviors)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestDataPropertyBehaviors/property_behaviors.tf"),
+					ExpectError: regexp.MustCompile("fetching available behaviors"),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -2,10 +2,12 @@ package property
 
 import (
 	"context"
+	"errors"
 	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
@@ -34,9 +36,11 @@ func dataSourceAkamaiPropertyHostnames() *schema.Resource {
 				ValidateDiagFunc: tools.IsNotBlank,
 			},
 			"version": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "This is a computed value - provider will always use 'latest' version, providing own version number is not supported",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Property version to list the hostnames for. The latest version is used if not provided",
 			},
 			"hostnames": {
 				Type:        schema.TypeList,
@@ -90,18 +94,24 @@ func dataAkamaiPropertyHostnamesRead(ctx context.Context, d *schema.ResourceData
 	}
 	propertyID = tools.AddPrefix(propertyID, "prp_")
 
-	latestVersion, err := client.GetLatestVersion(ctx, papi.GetLatestVersionRequest{
-		PropertyID: propertyID,
-		ContractID: contractID,
-		GroupID:    groupID,
-	})
-	if err != nil {
+	version, err := tools.GetIntValue("version", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
-
-	version := latestVersion.Version.PropertyVersion
-	contractID = latestVersion.ContractID
-	groupID = latestVersion.GroupID
+	if version == 0 {
+		latestVersion, err := client.GetLatestVersion(ctx, papi.GetLatestVersionRequest{
+			PropertyID: propertyID,
+			ContractID: contractID,
+			GroupID:    groupID,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		version = latestVersion.Version.PropertyVersion
+		contractID = latestVersion.ContractID
+		groupID = latestVersion.GroupID
+	}
 
 	if err := d.Set("version", version); err != nil {
 		return diag.FromErr(err)
@@ -198,6 +198,45 @@ func TestDataPropertyHostnames(t *testing.T) {
 
 		client.AssertExpectations(t)
 	})
+
+	t.Run("list hostnames for given version", func(t *testing.T) {
+		client := &mockpapi{}
+		hostnames := papi.HostnameResponseItems{Items: buildPropertyHostnames()}
+		hostnameItems := flattenHostnames(hostnames.Items)
+
+		client.On("GetPropertyVersionHostnames", mock.Anything, papi.GetPropertyVersionHostnamesRequest{
+			PropertyID:        "prp_test",
+			PropertyVersion:   3,
+			ContractID:        "ctr_test",
+			GroupID:           "grp_test",
+			ValidateHostnames: false,
+			IncludeCertStatus: true,
+		}).Return(&papi.GetPropertyVersionHostnamesResponse{
+			AccountID:       "act_test",
+			ContractID:      "ctr_test",
+			GroupID:         "grp_test",
+			PropertyID:      "prp_test",
+			PropertyVersion: 3,
+			Etag:            "etag",
+			Hostnames:       hostnames,
+		}, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDataPropertyHostnames/property_hostnames_version.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						buildAggregatedHostnamesTest(hostnameItems, "prp_test3", "grp_test", "ctr_test", "prp_test"),
+						resource.TestCheckResourceAttr("data.akamai_property_hostnames.akaprophosts", "version", "3"),
+					),
+				}},
+			})
+		})
+
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "GetLatestVersion", mock.Anything, mock.Anything)
+	})
 }
 
 func buildPropertyHostnames() []papi.Hostname {
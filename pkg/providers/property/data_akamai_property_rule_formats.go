@@ -3,6 +3,8 @@ package property
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,10 +21,24 @@ func dataPropertyRuleFormats() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			"frozen_rule_formats": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Frozen rule formats, oldest first",
+			},
+			"latest_frozen_rule_format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The most recent frozen rule format",
+			},
 		},
 	}
 }
 
+// frozenRuleFormat matches the dated rule formats, which never change once published
+var frozenRuleFormat = regexp.MustCompile(`^v\d{4}-\d{2}-\d{2}$`)
+
 func readPropertyRuleFormats(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
 	client := inst.Client(meta)
@@ -45,6 +61,25 @@ func readPropertyRuleFormats(ctx context.Context, d *schema.ResourceData, m inte
 	if err := d.Set("rule_format", ruleFormats.RuleFormats.Items); err != nil {
 		return diag.FromErr(err)
 	}
+
+	frozen := make([]string, 0, len(ruleFormats.RuleFormats.Items))
+	for _, format := range ruleFormats.RuleFormats.Items {
+		if frozenRuleFormat.MatchString(format) {
+			frozen = append(frozen, format)
+		}
+	}
+	// dates in the format names sort chronologically as strings
+	sort.Strings(frozen)
+	var latestFrozen string
+	if len(frozen) > 0 {
+		latestFrozen = frozen[len(frozen)-1]
+	}
+	if err := d.Set("frozen_rule_formats", frozen); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("latest_frozen_rule_format", latestFrozen); err != nil {
+		return diag.FromErr(err)
+	}
 	d.SetId("rule_format")
 
 	return nil
@@ -15,7 +15,10 @@ func Test_readPropertyRuleFormats(t *testing.T) {
 		rule_formats := papi.RuleFormatItems{
 			Items: []string{
 				"latest",
-				"v2015-08-08"}}
+				"v2015-08-08",
+				"v2020-11-02",
+				"v2018-09-12",
+				"v2021-01-01-beta"}}
 
 		client.On("GetRuleFormats",
 			mock.Anything,
@@ -29,6 +32,9 @@ func Test_readPropertyRuleFormats(t *testing.T) {
 						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "id", "rule_format"),
 						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "rule_format.0", "latest"),
 						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "rule_format.1", "v2015-08-08"),
+						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "frozen_rule_formats.#", "3"),
+						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "frozen_rule_formats.1", "v2018-09-12"),
+						resource.TestCheckResourceAttr("data.akamai_property_rule_formats.akarulesformats", "latest_frozen_rule_format", "v2020-11-02"),
 					),
 				}},
 			})
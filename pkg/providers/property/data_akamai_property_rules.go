@@ -3,10 +3,12 @@ package property
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
@@ -45,15 +47,24 @@ var dataAkamaiPropertyRuleSchema = map[string]*schema.Schema{
 	},
 	"property_id": {
 		Type:             schema.TypeString,
-		Required:         true,
+		Optional:         true,
+		Computed:         true,
 		StateFunc:        addPrefixToState("prp_"),
+		ExactlyOneOf:     []string{"property_id", "property_name"},
+		ValidateDiagFunc: tools.IsNotBlank,
+	},
+	"property_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
 		ValidateDiagFunc: tools.IsNotBlank,
+		Description:      "Name of the property to fetch the rules for, can be used instead of property_id",
 	},
 	"version": {
-		Type:        schema.TypeInt,
-		Optional:    true,
-		Computed:    true,
-		Description: "This is a computed value - provider will always use 'latest' version, providing own version number is not supported",
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  "Property version to fetch the rules for. The latest version is used if not provided",
 	},
 	"rules": {
 		Type:        schema.TypeString,
@@ -82,7 +93,25 @@ func dataPropertyRulesRead(ctx context.Context, d *schema.ResourceData, m interf
 	groupID, _ = tools.GetStringValue("group_id", d)
 
 	if propertyID, err = tools.GetStringValue("property_id", d); err != nil {
-		return diag.FromErr(err)
+		if !errors.Is(err, tools.ErrNotFound) {
+			return diag.FromErr(err)
+		}
+		propertyName, err := tools.GetStringValue("property_name", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		property, err := findProperty(ctx, propertyName, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		propertyID = property.PropertyID
+		if contractID == "" {
+			contractID = property.ContractID
+			groupID = property.GroupID
+		}
+		if err := d.Set("property_id", propertyID); err != nil {
+			return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+		}
 	}
 
 	if contractID != "" {
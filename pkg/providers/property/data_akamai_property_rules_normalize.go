@@ -0,0 +1,53 @@
+package property
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+func dataSourcePropertyRulesNormalize() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyRulesNormalizeRead,
+		Schema: map[string]*schema.Schema{
+			"rules": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				Description:  "Property rules as JSON",
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Normalized property rules as JSON",
+			},
+		},
+	}
+}
+
+func dataPropertyRulesNormalizeRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger := akamai.Meta(m).Log("PAPI", "dataPropertyRulesNormalizeRead")
+
+	rulesJSON, err := tools.GetStringValue("rules", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	normalized, err := NormalizeRules(rulesJSON)
+	if err != nil {
+		logger.WithError(err).Error("could not normalize rules")
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("json", normalized); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(tools.GetSHAString(normalized))
+
+	return nil
+}
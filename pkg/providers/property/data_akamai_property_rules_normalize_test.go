@@ -0,0 +1,24 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDSRulesNormalize(t *testing.T) {
+	t.Run("rules are normalized", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSRulesNormalize/rules_normalize.tf"),
+					Check: resource.TestCheckResourceAttr("data.akamai_property_rules_normalize.rules", "json",
+						`{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"1d"}}],"name":"default","options":{}}}`),
+				}},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+}
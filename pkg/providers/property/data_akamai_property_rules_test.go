@@ -67,6 +67,70 @@ func TestDSPropertyRulesRead(t *testing.T) {
 		})
 		client.AssertExpectations(t)
 	})
+	t.Run("get datasource property rules by name and version", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("SearchProperties", mock.Anything, papi.SearchRequest{
+			Key:   papi.SearchKeyPropertyName,
+			Value: "property_name",
+		}).Return(&papi.SearchResponse{Versions: papi.SearchItems{Items: []papi.SearchItem{
+			{ContractID: "ctr_2", GroupID: "grp_2", PropertyID: "prp_2"},
+		}}}, nil)
+		client.On("GetProperty", mock.Anything, papi.GetPropertyRequest{
+			ContractID: "ctr_2",
+			GroupID:    "grp_2",
+			PropertyID: "prp_2",
+		}).Return(&papi.GetPropertyResponse{Properties: papi.PropertiesItems{Items: []*papi.Property{
+			{ContractID: "ctr_2", GroupID: "grp_2", PropertyID: "prp_2", PropertyName: "property_name"},
+		}}}, nil)
+		client.On("GetRuleTree", mock.Anything, papi.GetRuleTreeRequest{
+			ContractID:      "ctr_2",
+			GroupID:         "grp_2",
+			PropertyID:      "prp_2",
+			PropertyVersion: 2,
+			ValidateRules:   true,
+			ValidateMode:    papi.RuleValidateModeFull,
+		}).Return(&papi.GetRuleTreeResponse{
+			Rules: papi.Rules{
+				Name: "some rule tree",
+			},
+		}, nil)
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestDSPropertyRules/ds_property_rules_by_name_version.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("data.akamai_property_rules.rules", "id", "prp_2"),
+							resource.TestCheckResourceAttr("data.akamai_property_rules.rules", "property_id", "prp_2"),
+							resource.TestCheckResourceAttr("data.akamai_property_rules.rules", "group_id", "grp_2"),
+							resource.TestCheckResourceAttr("data.akamai_property_rules.rules", "contract_id", "ctr_2"),
+							resource.TestCheckResourceAttr("data.akamai_property_rules.rules", "version", "2"),
+							resource.TestCheckResourceAttrSet("data.akamai_property_rules.rules", "rules"),
+						),
+					},
+				},
+			})
+		})
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "GetLatestVersion", mock.Anything, mock.Anything)
+	})
+
+	t.Run("property_id or property_name is required", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config:      loadFixtureString("testdata/TestDSPropertyRules/missing_property.tf"),
+						ExpectError: regexp.MustCompile("one of `property_id,property_name` must be specified"),
+					},
+				},
+			})
+		})
+	})
+
 	t.Run("group_id is required with contract_id", func(t *testing.T) {
 		client := &mockpapi{}
 		useClient(client, func() {
@@ -0,0 +1,98 @@
+package property
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+// variableName matches the names Property Manager accepts for user-defined variables
+var variableName = regexp.MustCompile(`^PMUSER_[A-Z0-9_]+$`)
+
+func dataSourcePropertyRulesVariables() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataPropertyRulesVariablesRead,
+		Schema: map[string]*schema.Schema{
+			"variable": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(variableName, "must start with PMUSER_ followed by uppercase letters, digits or underscores"),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"hidden": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"sensitive": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON variables representation, to be set as the variables of the default rule",
+			},
+		},
+	}
+}
+
+func dataPropertyRulesVariablesRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logger := akamai.Meta(m).Log("PAPI", "dataPropertyRulesVariablesRead")
+
+	variables := make([]papi.RuleVariable, 0)
+	declared := map[string]bool{}
+	for _, v := range d.Get("variable").([]interface{}) {
+		variable := v.(map[string]interface{})
+		name := variable["name"].(string)
+		if declared[name] {
+			return diag.Errorf("variable %s: declared more than once", name)
+		}
+		declared[name] = true
+		if variable["sensitive"].(bool) && !variable["hidden"].(bool) {
+			return diag.Errorf("variable %s: sensitive variables must also be hidden", name)
+		}
+		variables = append(variables, papi.RuleVariable{
+			Name:        name,
+			Value:       variable["value"].(string),
+			Description: variable["description"].(string),
+			Hidden:      variable["hidden"].(bool),
+			Sensitive:   variable["sensitive"].(bool),
+		})
+	}
+	logger.Debugf("Built %d variables", len(variables))
+
+	variablesJSON, err := json.Marshal(variables)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("json", string(variablesJSON)); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	d.SetId(tools.GetSHAString(string(variablesJSON)))
+	return nil
+}
@@ -0,0 +1,61 @@
+package property
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDSRulesVariables(t *testing.T) {
+	t.Run("variables are built", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSRulesVariables/variables.tf"),
+					Check: resource.TestCheckResourceAttr("data.akamai_property_rules_variables.variables", "json",
+						`[{"hidden":false,"name":"PMUSER_ORIGIN","sensitive":false,"value":"origin.example.com"},{"description":"Shared secret","hidden":true,"name":"PMUSER_SECRET","sensitive":true}]`),
+				}},
+			})
+		})
+		client.AssertExpectations(t)
+	})
+	t.Run("invalid variable name", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestDSRulesVariables/invalid_variable_name.tf"),
+					ExpectError: regexp.MustCompile("must start with PMUSER_"),
+				}},
+			})
+		})
+	})
+	t.Run("sensitive variable which is not hidden", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestDSRulesVariables/sensitive_not_hidden.tf"),
+					ExpectError: regexp.MustCompile("variable PMUSER_SECRET: sensitive variables must also be hidden"),
+				}},
+			})
+		})
+	})
+	t.Run("variable declared twice", func(t *testing.T) {
+		client := &mockpapi{}
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestDSRulesVariables/duplicate_name.tf"),
+					ExpectError: regexp.MustCompile("variable PMUSER_ORIGIN: declared more than once"),
+				}},
+			})
+		})
+	})
+}
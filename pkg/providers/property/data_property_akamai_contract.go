@@ -3,10 +3,12 @@ package property
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
@@ -79,24 +81,37 @@ func dataSourcePropertyContractRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	var matchingGroups []*papi.Group
 	for _, g := range groups.Groups.Items {
 		if g.GroupID != group && g.GroupID != tools.AddPrefix(group, "grp_") && g.GroupName != group {
 			continue
 		}
-		if len(g.ContractIDs) == 0 {
-			return diag.Errorf("%v: %v", ErrLookingUpContract, group)
+		matchingGroups = append(matchingGroups, g)
+	}
+	if len(matchingGroups) == 0 {
+		return diag.Errorf("%v; groupID: %v", ErrNoContractsFound, group)
+	}
+	// group names are not unique, so ask for the group ID instead of returning the contract of any of them
+	if len(matchingGroups) > 1 {
+		ids := make([]string, 0, len(matchingGroups))
+		for _, g := range matchingGroups {
+			ids = append(ids, g.GroupID)
 		}
+		return diag.Errorf("%v: %q, use group_id instead, one of: %s", ErrGroupAmbiguous, group, strings.Join(ids, ", "))
+	}
 
-		// set group_id/group_name/group in state.
-		if err := d.Set("group_id", tools.AddPrefix(g.GroupID, "grp_")); err != nil {
-			return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
-		}
-		if err := d.Set("group_name", g.GroupName); err != nil {
-			return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
-		}
-		d.SetId(g.ContractIDs[0])
-		return nil
+	g := matchingGroups[0]
+	if len(g.ContractIDs) == 0 {
+		return diag.Errorf("%v: %v", ErrLookingUpContract, group)
 	}
 
-	return diag.Errorf("%v; groupID: %v", ErrNoContractsFound, group)
+	// set group_id/group_name/group in state.
+	if err := d.Set("group_id", tools.AddPrefix(g.GroupID, "grp_")); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	if err := d.Set("group_name", g.GroupName); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+	d.SetId(g.ContractIDs[0])
+	return nil
 }
@@ -243,4 +243,33 @@ func Test_DSReadContract(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("read contract with ambiguous group name", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetGroups", AnyCTX).Return(&papi.GetGroupsResponse{
+			AccountID: "act_1-1TJZFB", AccountName: "example.com",
+			Groups: papi.GroupItems{Items: []*papi.Group{
+				{
+					GroupID:       "grp_12345",
+					GroupName:     "Example.com-1-1TJZH5",
+					ParentGroupID: "grp_parent",
+					ContractIDs:   []string{"ctr_1234"},
+				},
+				{
+					GroupID:       "grp_12346",
+					GroupName:     "Example.com-1-1TJZH5",
+					ParentGroupID: "grp_other",
+					ContractIDs:   []string{"ctr_5678"},
+				},
+			}}}, nil)
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{{
+					Config:      loadFixtureString("testdata/TestDSContractRequired/ds_contract_with_group_name.tf"),
+					ExpectError: regexp.MustCompile("more than one group matches: \"Example.com-1-1TJZH5\", use group_id instead, one of: grp_12345, grp_12346"),
+				}},
+			})
+		})
+	})
 }
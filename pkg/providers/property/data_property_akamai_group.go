@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
@@ -23,14 +25,27 @@ func dataSourcePropertyGroup() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"name", "group_name"},
+				ExactlyOneOf: []string{"name", "group_name", "group_name_regex"},
 				Deprecated:   akamai.NoticeDeprecatedUseAlias("name"),
 			},
 			"group_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ExactlyOneOf: []string{"name", "group_name"},
+				ExactlyOneOf: []string{"name", "group_name", "group_name_regex"},
+			},
+			"group_name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"name", "group_name", "group_name_regex"},
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  "Regular expression the name of the group has to match",
+			},
+			"parent_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Names of the ancestor groups separated by '/', starting with the top-level group",
 			},
 			"contract": {
 				Type:         schema.TypeString,
@@ -59,19 +74,30 @@ func dataSourcePropertyGroupRead(ctx context.Context, d *schema.ResourceData, m
 		session.WithContextLog(log),
 	)
 
-	var (
-		name       string
-		getDefault bool
-	)
+	var lookup groupLookup
 
 	// check and load group_name, if not exists then check group.
 	name, err := tools.ResolveKeyStringState(d, "group_name", "name")
-	if err != nil {
-		if !errors.Is(err, tools.ErrNotFound) {
-			return diag.FromErr(err)
-		}
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	lookup.name = name
+	nameRegex, err := tools.GetStringValue("group_name_regex", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
+	}
+	if nameRegex != "" {
+		// regular expression was already checked by schema validation
+		lookup.nameRegex = regexp.MustCompile(nameRegex)
+		name = nameRegex
+	}
+	if name == "" {
 		name = "default"
-		getDefault = true
+		lookup.isDefault = true
+	}
+	lookup.parentPath, err = tools.GetStringValue("parent_path", d)
+	if err != nil && !errors.Is(err, tools.ErrNotFound) {
+		return diag.FromErr(err)
 	}
 
 	log.Debugf("[Akamai Property Group] Start Searching for property group records %s ", name)
@@ -86,12 +112,17 @@ func dataSourcePropertyGroupRead(ctx context.Context, d *schema.ResourceData, m
 	if err != nil && !errors.Is(err, tools.ErrNotFound) {
 		return diag.FromErr(err)
 	}
+	lookup.contract = contractID
 
-	group, err := findGroupByName(name, contractID, groups, getDefault)
+	group, err := findGroupByName(lookup, groups)
 	if err != nil {
 		return diag.Errorf("%v: %v: %v", ErrLookingUpGroupByName, name, err)
 	}
 
+	if err := d.Set("parent_path", groupParentPath(group, groups)); err != nil {
+		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
+	}
+
 	// set group_name/name in state.
 	if err := d.Set("group_name", group.GroupName); err != nil {
 		return diag.Errorf("%v: %s", tools.ErrValueSet, err.Error())
@@ -115,23 +146,33 @@ func dataSourcePropertyGroupRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// groupLookup holds the criteria to find a group by
+type groupLookup struct {
+	name       string
+	nameRegex  *regexp.Regexp
+	parentPath string
+	contract   string
+	isDefault  bool
+}
+
 /*
 findGroupByName returns Group struct based on provided name, contract and default name provided
 for default name, either a group is returned based on provided contract, or in case of empty contract, first group is returned
 TODO: we should decide whether returning first group from slice of groups is proper business behaviour
 
 for non-default name, if contract was provided, a group with matching contract ID should be returned
-in case of non-default name, contract is mandatory
+in case of non-default name, contract is mandatory. The name can be given as a regular expression, and the groups can be
+narrowed down by the path of their parent groups. If more than one group matches, an error listing them is returned
 */
-func findGroupByName(name, contract string, groups *papi.GetGroupsResponse, isDefault bool) (*papi.Group, error) {
+func findGroupByName(lookup groupLookup, groups *papi.GetGroupsResponse) (*papi.Group, error) {
 	var group *papi.Group
 
-	if isDefault {
-		name = groups.AccountName
-		if contract != "" {
+	if lookup.isDefault {
+		name := groups.AccountName
+		if lookup.contract != "" {
 			var found bool
 
-			name += "-" + strings.TrimPrefix(contract, "ctr_")
+			name += "-" + strings.TrimPrefix(lookup.contract, "ctr_")
 			for _, group = range groups.Groups.Items {
 				if group.GroupID == name {
 					found = true
@@ -151,26 +192,72 @@ func findGroupByName(name, contract string, groups *papi.GetGroupsResponse, isDe
 		return groups.Groups.Items[0], nil
 	}
 
+	name := lookup.name
+	if lookup.nameRegex != nil {
+		name = lookup.nameRegex.String()
+	}
+
 	// for non-default name, contract is required
-	if contract == "" {
+	if lookup.contract == "" {
 		return nil, fmt.Errorf("%v: %s", ErrNoContractProvided, name)
 	}
 
 	var foundGroups []*papi.Group
 	for _, group := range groups.Groups.Items {
-		if group.GroupName == name {
-			foundGroups = append(foundGroups, group)
+		if lookup.nameRegex != nil && !lookup.nameRegex.MatchString(group.GroupName) {
+			continue
+		}
+		if lookup.nameRegex == nil && group.GroupName != lookup.name {
+			continue
 		}
+		if lookup.parentPath != "" && strings.Trim(lookup.parentPath, "/") != groupParentPath(group, groups) {
+			continue
+		}
+		foundGroups = append(foundGroups, group)
 	}
 	// Make sure the group belongs to the specified contract
+	var matchingGroups []*papi.Group
 	for _, foundGroup := range foundGroups {
 		for _, c := range foundGroup.ContractIDs {
-			if c == contract || c == "ctr_"+contract {
-				return foundGroup, nil
+			if c == lookup.contract || c == "ctr_"+lookup.contract {
+				matchingGroups = append(matchingGroups, foundGroup)
+				break
 			}
 		}
 	}
-	return nil, fmt.Errorf("%v: %s", ErrGroupNotInContract, contract)
+	switch len(matchingGroups) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", ErrGroupNotInContract, lookup.contract)
+	case 1:
+		return matchingGroups[0], nil
+	}
+
+	matches := make([]string, 0, len(matchingGroups))
+	for _, g := range matchingGroups {
+		matches = append(matches, fmt.Sprintf("%s (%s)", g.GroupID, strings.TrimPrefix(groupParentPath(g, groups)+"/"+g.GroupName, "/")))
+	}
+	return nil, fmt.Errorf("%w, use parent_path to select one of: %s", ErrGroupAmbiguous, strings.Join(matches, ", "))
+}
+
+// groupParentPath returns the names of the ancestors of the given group separated by '/', starting with the top-level group
+func groupParentPath(group *papi.Group, groups *papi.GetGroupsResponse) string {
+	byID := make(map[string]*papi.Group, len(groups.Groups.Items))
+	for _, g := range groups.Groups.Items {
+		byID[g.GroupID] = g
+	}
+
+	var path []string
+	visited := map[string]bool{group.GroupID: true}
+	for parentID := group.ParentGroupID; parentID != "" && !visited[parentID]; {
+		parent, ok := byID[parentID]
+		if !ok {
+			break
+		}
+		visited[parentID] = true
+		path = append([]string{parent.GroupName}, path...)
+		parentID = parent.ParentGroupID
+	}
+	return strings.Join(path, "/")
 }
 
 func getGroups(ctx context.Context, meta akamai.OperationMeta) (*papi.GetGroupsResponse, error) {
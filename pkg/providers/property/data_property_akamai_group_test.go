@@ -1,10 +1,13 @@
 package property
 
 import (
+	"errors"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 )
@@ -153,7 +156,7 @@ func Test_DSReadGroup(t *testing.T) {
 				IsUnitTest: true,
 				Steps: []resource.TestStep{{
 					Config:      loadFixtureString("testdata/TestDSGroup/ds-group-w-group-name-and-name-conflict.tf"),
-					ExpectError: regexp.MustCompile("only one of `group_name,name` can be specified"),
+					ExpectError: regexp.MustCompile("only one of `group_name,group_name_regex,name` can be specified"),
 				}},
 			})
 		})
@@ -183,4 +186,86 @@ func Test_DSReadGroup(t *testing.T) {
 			})
 		})
 	})
+
+	t.Run("read group with name regex and parent path", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetGroups", AnyCTX).Return(&papi.GetGroupsResponse{
+			AccountID: "act_1-1TJZFB", AccountName: "example.com",
+			Groups: papi.GroupItems{Items: buildNestedGroups()}}, nil)
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers:  testAccProviders,
+				IsUnitTest: true,
+				Steps: []resource.TestStep{{
+					Config: loadFixtureString("testdata/TestDSGroup/ds-group-w-name-regex-and-parent-path.tf"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("data.akamai_group.akagroup", "id", "grp_3"),
+						resource.TestCheckResourceAttr("data.akamai_group.akagroup", "group_name", "web"),
+						resource.TestCheckResourceAttr("data.akamai_group.akagroup", "parent_path", "example.com/Division B"),
+						resource.TestCheckResourceAttr("data.akamai_group.akagroup", "contract_id", "ctr_1234"),
+					),
+				}},
+			})
+		})
+	})
+}
+
+func TestFindGroupByName(t *testing.T) {
+	groups := &papi.GetGroupsResponse{
+		AccountName: "example.com",
+		Groups:      papi.GroupItems{Items: buildNestedGroups()},
+	}
+
+	tests := map[string]struct {
+		lookup     groupLookup
+		expectedID string
+		withError  error
+	}{
+		"unique name": {
+			lookup:     groupLookup{name: "Division A", contract: "ctr_1234"},
+			expectedID: "grp_1",
+		},
+		"duplicate name": {
+			lookup:    groupLookup{name: "web", contract: "ctr_1234"},
+			withError: ErrGroupAmbiguous,
+		},
+		"duplicate name with parent path": {
+			lookup:     groupLookup{name: "web", parentPath: "example.com/Division A", contract: "ctr_1234"},
+			expectedID: "grp_2",
+		},
+		"name regex": {
+			lookup:     groupLookup{nameRegex: regexp.MustCompile("^Division B$"), contract: "1234"},
+			expectedID: "grp_4",
+		},
+		"ambiguous name regex": {
+			lookup:    groupLookup{nameRegex: regexp.MustCompile("^Division"), contract: "ctr_1234"},
+			withError: ErrGroupAmbiguous,
+		},
+		"parent path not found": {
+			lookup:    groupLookup{name: "web", parentPath: "example.com/Division C", contract: "ctr_1234"},
+			withError: ErrGroupNotInContract,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			group, err := findGroupByName(test.lookup, groups)
+			if test.withError != nil {
+				assert.True(t, errors.Is(err, test.withError), "want: %s; got: %s", test.withError, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedID, group.GroupID)
+		})
+	}
+}
+
+func buildNestedGroups() []*papi.Group {
+	return []*papi.Group{
+		{GroupID: "grp_0", GroupName: "example.com", ContractIDs: []string{"ctr_1234"}},
+		{GroupID: "grp_1", GroupName: "Division A", ParentGroupID: "grp_0", ContractIDs: []string{"ctr_1234"}},
+		{GroupID: "grp_2", GroupName: "web", ParentGroupID: "grp_1", ContractIDs: []string{"ctr_1234"}},
+		{GroupID: "grp_3", GroupName: "web", ParentGroupID: "grp_4", ContractIDs: []string{"ctr_1234"}},
+		{GroupID: "grp_4", GroupName: "Division B", ParentGroupID: "grp_0", ContractIDs: []string{"ctr_1234"}},
+	}
 }
@@ -24,7 +24,8 @@ func compareRulesJSON(old, new string) bool {
 }
 
 func compareRuleTree(old, new *papi.RulesUpdate) bool {
-	if old.Comments != new.Comments {
+	// comments hold the version notes, which can be edited outside of the rules, so they are only compared when set
+	if new.Comments != "" && old.Comments != new.Comments {
 		return false
 	}
 	diff := compareRules(&old.Rules, &new.Rules)
@@ -73,14 +74,105 @@ func compareRules(old, new *papi.Rules) bool {
 	old.Variables = orderVariables(old.Variables)
 	new.Variables = orderVariables(new.Variables)
 
+	// identifiers are generated by PAPI, so they are only compared when set in the new rules
+	if new.UUID == "" {
+		old.UUID = ""
+	}
+	if new.TemplateUuid == "" {
+		old.TemplateUuid = ""
+	}
+	for i := range old.Behaviors {
+		discardInjectedDefaults(&old.Behaviors[i], &new.Behaviors[i])
+	}
+	for i := range old.Criteria {
+		discardInjectedDefaults(&old.Criteria[i], &new.Criteria[i])
+	}
+
 	return reflect.DeepEqual(old, new)
 }
 
+// injectedOptionDefaults lists, per behavior or criteria name, the non-empty option values PAPI fills in
+// when the option is omitted in the request
+var injectedOptionDefaults = map[string]papi.RuleOptionsMap{
+	"allowPost": {
+		"enabled": true,
+	},
+	"origin": {
+		"cacheKeyHostname":  "ORIGIN_HOSTNAME",
+		"compress":          true,
+		"forwardHostHeader": "REQUEST_HOST_HEADER",
+		"httpPort":          float64(80),
+		"httpsPort":         float64(443),
+		"originSni":         true,
+		"originType":        "CUSTOMER",
+		"verificationMode":  "PLATFORM_SETTINGS",
+	},
+}
+
+// discardInjectedDefaults removes from the old behavior (as returned by PAPI) the identifiers and the options which
+// are not present in the new one, provided the old value is a default injected by PAPI: either an empty value or one
+// listed in injectedOptionDefaults. Options explicitly set to another value are kept, so removing them produces a diff
+func discardInjectedDefaults(old, new *papi.RuleBehavior) {
+	if old.Name != new.Name {
+		return
+	}
+	if new.UUID == "" {
+		old.UUID = ""
+	}
+	if new.TemplateUuid == "" {
+		old.TemplateUuid = ""
+	}
+	if new.Options == nil {
+		new.Options = papi.RuleOptionsMap{}
+	}
+	old.Options = discardInjectedOptions(old.Options, new.Options, injectedOptionDefaults[old.Name])
+}
+
+// discardInjectedOptions returns a copy of old without the injected defaults missing in new, descending into
+// nested option objects present in both maps
+func discardInjectedOptions(old, new, defaults map[string]interface{}) map[string]interface{} {
+	options := make(map[string]interface{}, len(old))
+	for name, value := range old {
+		newValue, ok := new[name]
+		if !ok {
+			if defaultValue, ok := defaults[name]; isEmptyOption(value) || ok && reflect.DeepEqual(value, defaultValue) {
+				continue
+			}
+			options[name] = value
+			continue
+		}
+		oldNested, oldIsMap := value.(map[string]interface{})
+		newNested, newIsMap := newValue.(map[string]interface{})
+		if oldIsMap && newIsMap {
+			value = discardInjectedOptions(oldNested, newNested, nil)
+		}
+		options[name] = value
+	}
+	return options
+}
+
+// isEmptyOption checks whether the option value is one PAPI uses for unset options
+func isEmptyOption(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
 func orderBehaviors(behaviors []papi.RuleBehavior) []papi.RuleBehavior {
 	if len(behaviors) == 0 {
 		return nil
 	}
-	sort.Slice(behaviors, func(i, j int) bool {
+	sort.SliceStable(behaviors, func(i, j int) bool {
 		return behaviors[i].Name < behaviors[j].Name
 	})
 	return behaviors
@@ -597,6 +597,234 @@ func TestCompareRules(t *testing.T) {
 			},
 			expected: true,
 		},
+		"equal rules, defaults and uuids injected by API": {
+			old: &papi.Rules{
+				Name: "default",
+				UUID: "default-uuid",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior":       "MAX_AGE",
+							"ttl":            "1d",
+							"mustRevalidate": false,
+						},
+						UUID: "4535543",
+					},
+					{
+						Name:    "allowPost",
+						Options: papi.RuleOptionsMap{"enabled": true},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name:    "allowPost",
+						Options: nil,
+					},
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior": "MAX_AGE",
+							"ttl":      "1d",
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"different rules, different option value": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior":       "MAX_AGE",
+							"ttl":            "1d",
+							"mustRevalidate": false,
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior": "MAX_AGE",
+							"ttl":      "2d",
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"different rules, explicitly set option removed": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior":       "MAX_AGE",
+							"ttl":            "1d",
+							"mustRevalidate": true,
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior": "MAX_AGE",
+							"ttl":      "1d",
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"different rules, option with non-default value removed": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"hostname":          "origin.example.com",
+							"forwardHostHeader": "ORIGIN_HOSTNAME",
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name:    "origin",
+						Options: papi.RuleOptionsMap{"hostname": "origin.example.com"},
+					},
+				},
+			},
+			expected: false,
+		},
+		"equal rules, known defaults injected by API": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"hostname":          "origin.example.com",
+							"forwardHostHeader": "REQUEST_HOST_HEADER",
+							"httpPort":          float64(80),
+							"customHeaders":     []interface{}{},
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name:    "origin",
+						Options: papi.RuleOptionsMap{"hostname": "origin.example.com"},
+					},
+				},
+			},
+			expected: true,
+		},
+		"equal rules, defaults injected in nested options": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"netStorage": map[string]interface{}{
+								"downloadDomainName": "example.download.akamai.com",
+								"g2oToken":           "",
+							},
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"netStorage": map[string]interface{}{
+								"downloadDomainName": "example.download.akamai.com",
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		"different rules, explicitly set nested option removed": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"netStorage": map[string]interface{}{
+								"downloadDomainName": "example.download.akamai.com",
+								"cpCode":             float64(123),
+							},
+						},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "origin",
+						Options: papi.RuleOptionsMap{
+							"netStorage": map[string]interface{}{
+								"downloadDomainName": "example.download.akamai.com",
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		"different rules, option added": {
+			old: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name:    "caching",
+						Options: papi.RuleOptionsMap{"behavior": "MAX_AGE"},
+					},
+				},
+			},
+			new: &papi.Rules{
+				Name: "default",
+				Behaviors: []papi.RuleBehavior{
+					{
+						Name: "caching",
+						Options: papi.RuleOptionsMap{
+							"behavior": "MAX_AGE",
+							"ttl":      "1d",
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -606,3 +834,33 @@ func TestCompareRules(t *testing.T) {
 		})
 	}
 }
+
+func TestCompareRuleTree(t *testing.T) {
+	tests := map[string]struct {
+		old      *papi.RulesUpdate
+		new      *papi.RulesUpdate
+		expected bool
+	}{
+		"version notes are ignored when not configured": {
+			old:      &papi.RulesUpdate{Comments: "notes from control center", Rules: papi.Rules{Name: "default"}},
+			new:      &papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+			expected: true,
+		},
+		"different version notes": {
+			old:      &papi.RulesUpdate{Comments: "old notes", Rules: papi.Rules{Name: "default"}},
+			new:      &papi.RulesUpdate{Comments: "new notes", Rules: papi.Rules{Name: "default"}},
+			expected: false,
+		},
+		"different rules": {
+			old:      &papi.RulesUpdate{Rules: papi.Rules{Name: "default"}},
+			new:      &papi.RulesUpdate{Rules: papi.Rules{Name: "other"}},
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, compareRuleTree(test.old, test.new))
+		})
+	}
+}
@@ -5,6 +5,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	// certTypeCPSManaged is the provisioning type of certificates managed with the Certificate Provisioning System
+	certTypeCPSManaged = "CPS_MANAGED"
+	// certTypeDefault is the provisioning type of secure by default certificates
+	certTypeDefault = "DEFAULT"
+	// certStatusDeployed is the status of a default certificate deployed to the network
+	certStatusDeployed = "DEPLOYED"
+)
+
 var certStatus = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"target": {
@@ -15,6 +15,8 @@ var (
 	ErrNoGroupsFound = errors.New("no groups found")
 	// ErrGroupNotInContract is returned when none of the groups could be associated with given contractID
 	ErrGroupNotInContract = errors.New("group does not belong to contract")
+	// ErrGroupAmbiguous is returned when more than one group matches the lookup criteria
+	ErrGroupAmbiguous = errors.New("more than one group matches")
 	// ErrFetchingGroups represents error while fetching groups
 	ErrFetchingGroups = errors.New("fetching groups")
 	// ErrGroupNotFound is returned when group with provided ID is not found
@@ -67,9 +69,62 @@ var (
 
 	// ErrRuleFormatsNotFound is returned when no rule formats were found
 	ErrRuleFormatsNotFound = errors.New("no rule formats found")
+	// ErrRuleFormatNotAvailable is returned when the requested rule format is not offered by PAPI
+	ErrRuleFormatNotAvailable = errors.New("rule format not available")
+	// ErrRulesValidation is returned when PAPI reports errors for the rules validated during plan
+	ErrRulesValidation = errors.New("rules validation failed")
 
 	// ErrEdgeHostnameNotFound is returned when no edgehostname were found
 	ErrEdgeHostnameNotFound = errors.New("unable to find edge hostname")
+	// ErrEdgeHostnameAutoCreate is returned when an edge hostname can't be created from the hostnames block
+	ErrEdgeHostnameAutoCreate = errors.New("only edgesuite.net and akamaized.net edge hostnames can be created automatically, use akamai_edge_hostname for")
+
+	// DiagWarnDefaultCertsTimeout returned on timeout while waiting for default certificates
+	DiagWarnDefaultCertsTimeout = diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Timeout waiting for default certificates",
+		Detail: `
+The property version has been activated, however the operation timeout was exceeded while waiting
+for the certificates of hostnames with the DEFAULT provisioning type to be deployed. Check that the
+validation CNAME records in the 'cert_status' of the property hostnames exist in your DNS zone.`,
+	}
+
+	// DiagWarnEdgeHostnameNotDeleted returned when an edge hostname is removed from state only
+	DiagWarnEdgeHostnameNotDeleted = diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Edge hostname was not deleted",
+		Detail: `
+The edge hostname has been removed from the Terraform state, but it still exists on the Akamai platform,
+as the Property Manager API doesn't support deleting edge hostnames. Contact Akamai support to delete it.`,
+	}
+
+	// DiagWarnCPCodeNotDeleted returned when a CP code is removed from state only
+	DiagWarnCPCodeNotDeleted = diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "CP code was not deleted",
+		Detail: `
+The CP code has been removed from the Terraform state, but it still exists on the Akamai platform,
+as the Property Manager API doesn't support deleting CP codes. Creating a CP code with the same name,
+contract and group reuses it.`,
+	}
+
+	// DiagWarnClientSettingsNotReset returned when client settings are removed from state only
+	DiagWarnClientSettingsNotReset = diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Client settings were not reset",
+		Detail: `
+The client settings have been removed from the Terraform state, but the account keeps using them,
+as the Property Manager API doesn't support deleting client settings.`,
+	}
+
+	// DiagWarnActivationNotDeactivated returned when an activation is removed from state without deactivating the property
+	DiagWarnActivationNotDeactivated = diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Property was not deactivated",
+		Detail: `
+The activation has been removed from the Terraform state, but the property version is still active on the network,
+because deactivate_on_destroy is set to false.`,
+	}
 
 	// DiagWarnActivationTimeout returned on activation poll timeout
 	DiagWarnActivationTimeout = diag.Diagnostic{
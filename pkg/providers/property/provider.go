@@ -72,25 +72,31 @@ func Provider() *schema.Provider {
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"akamai_contract":                dataSourcePropertyContract(),
-			"akamai_contracts":               dataSourceAkamaiContracts(),
-			"akamai_cp_code":                 dataSourceCPCode(),
-			"akamai_group":                   dataSourcePropertyGroup(),
-			"akamai_groups":                  dataSourcePropertyMultipleGroups(),
-			"akamai_property_rules":          dataPropertyRules(),
-			"akamai_property_rule_formats":   dataPropertyRuleFormats(),
-			"akamai_property":                dataSourceAkamaiProperty(),
-			"akamai_property_rules_template": dataSourcePropertyRulesTemplate(),
-			"akamai_properties":              dataSourceAkamaiProperties(),
-			"akamai_property_products":       dataSourceAkamaiPropertyProducts(),
-			"akamai_property_hostnames":      dataSourceAkamaiPropertyHostnames(),
+			"akamai_contract":                 dataSourcePropertyContract(),
+			"akamai_contracts":                dataSourceAkamaiContracts(),
+			"akamai_cp_code":                  dataSourceCPCode(),
+			"akamai_edge_hostnames":           dataSourceAkamaiEdgeHostnames(),
+			"akamai_group":                    dataSourcePropertyGroup(),
+			"akamai_groups":                   dataSourcePropertyMultipleGroups(),
+			"akamai_property_rules":           dataPropertyRules(),
+			"akamai_property_rule_formats":    dataPropertyRuleFormats(),
+			"akamai_property":                 dataSourceAkamaiProperty(),
+			"akamai_property_rules_template":  dataSourcePropertyRulesTemplate(),
+			"akamai_property_rules_normalize": dataSourcePropertyRulesNormalize(),
+			"akamai_property_rules_variables": dataSourcePropertyRulesVariables(),
+			"akamai_properties":               dataSourceAkamaiProperties(),
+			"akamai_property_products":        dataSourceAkamaiPropertyProducts(),
+			"akamai_property_hostnames":       dataSourceAkamaiPropertyHostnames(),
+			"akamai_property_behaviors":       dataSourceAkamaiPropertyBehaviors(),
+			"akamai_property_activations":     dataSourceAkamaiPropertyActivations(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"akamai_cp_code":             resourceCPCode(),
-			"akamai_edge_hostname":       resourceSecureEdgeHostName(),
-			"akamai_property":            resourceProperty(),
-			"akamai_property_variables":  resourcePropertyVariables(),
-			"akamai_property_activation": resourcePropertyActivation(),
+			"akamai_cp_code":                  resourceCPCode(),
+			"akamai_edge_hostname":            resourceSecureEdgeHostName(),
+			"akamai_property":                 resourceProperty(),
+			"akamai_property_variables":       resourcePropertyVariables(),
+			"akamai_property_activation":      resourcePropertyActivation(),
+			"akamai_property_client_settings": resourcePropertyClientSettings(),
 		},
 	}
 	return provider
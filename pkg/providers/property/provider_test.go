@@ -11,6 +11,8 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/apex/log"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -57,6 +59,25 @@ func useClient(client papi.PAPI, f func()) {
 	f()
 }
 
+// testMeta is the meta passed to resource functions called directly by tests, outside of the Terraform test driver
+type testMeta struct{}
+
+func (testMeta) Log(args ...interface{}) log.Interface {
+	return akamai.LogFromHCLog(hclog.NewNullLogger())
+}
+
+func (testMeta) OperationID() string { return "test" }
+
+func (testMeta) Session() session.Session { return nil }
+
+func (testMeta) CacheGet(_ akamai.Subprovider, _ string, _ interface{}) error {
+	return akamai.ErrCacheDisabled
+}
+
+func (testMeta) CacheSet(_ akamai.Subprovider, _ string, _ interface{}) error {
+	return akamai.ErrCacheDisabled
+}
+
 // TODO marks a test as being in a "pending" state and logs a message telling the user why. Such tests are expected to
 // fail for the time being and may exist for the sake of unfinished/future features or to document known buggy cases
 // that won't be fixed right away. The failure of a pending test is not considered an error and the test will therefore
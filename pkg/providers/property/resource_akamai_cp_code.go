@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -13,6 +14,14 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 )
 
+var (
+	// cpCodeReadInterval is the interval for polling a newly created CP code until it is listed by PAPI
+	cpCodeReadInterval = 10 * time.Second
+
+	// cpCodeCreateTimeout is the default timeout for the CP code to show up after creation
+	cpCodeCreateTimeout = 5 * time.Minute
+)
+
 // PAPI CP Code
 //
 // https://developer.akamai.com/api/luna/papi/data.html#cpcode
@@ -26,7 +35,10 @@ func resourceCPCode() *schema.Resource {
 		},
 
 		// NB: CP Codes cannot be deleted https://developer.akamai.com/api/luna/papi/resources.html#cpcodesapi
-		DeleteContext: schema.NoopContext,
+		DeleteContext: resourceCPCodeDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: &cpCodeCreateTimeout,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -133,6 +145,11 @@ func resourceCPCodeCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		}
 
 		d.SetId(cpcID)
+
+		// A new CP code takes a while to be listed, wait for it so that the read doesn't fail
+		if err := waitForCPCode(ctx, cpcID, contractID, groupID, meta); err != nil {
+			return diag.FromErr(err)
+		}
 	} else {
 		d.SetId(cpCode.ID)
 	}
@@ -217,6 +234,14 @@ func resourceCPCodeRead(ctx context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 
+func resourceCPCodeDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourceCPCodeDelete")
+	logger.Info("PAPI does not support CP code deletion - resource will only be removed from state")
+	d.SetId("")
+	return diag.Diagnostics{DiagWarnCPCodeNotDeleted}
+}
+
 func resourceCPCodeImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	meta := akamai.Meta(m)
 	logger := meta.Log("PAPI", "resourceCPCodeImport")
@@ -273,3 +298,21 @@ func createCPCode(ctx context.Context, name, productID, contractID, groupID stri
 
 	return r.CPCodeID, nil
 }
+
+// waitForCPCode polls PAPI until the CP code with given ID is listed or the context is done
+func waitForCPCode(ctx context.Context, cpCodeID, contractID, groupID string, meta akamai.OperationMeta) error {
+	for {
+		cpCode, err := findCPCode(ctx, cpCodeID, contractID, groupID, meta)
+		if err != nil {
+			return fmt.Errorf("%s: %w", ErrLookingUpCPCode, err)
+		}
+		if cpCode != nil {
+			return nil
+		}
+		select {
+		case <-time.After(cpCodeReadInterval):
+		case <-ctx.Done():
+			return fmt.Errorf("%w: CP code %s was created but is not listed yet: %s", ErrLookingUpCPCode, cpCodeID, ctx.Err())
+		}
+	}
+}
@@ -2,10 +2,13 @@ package property
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/tj/assert"
 
@@ -324,3 +327,81 @@ func TestResCPCode(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 }
+
+func TestWaitForCPCode(t *testing.T) {
+	interval := cpCodeReadInterval
+	cpCodeReadInterval = time.Millisecond
+	defer func() { cpCodeReadInterval = interval }()
+
+	req := papi.GetCPCodesRequest{ContractID: "ctr_1", GroupID: "grp_1"}
+	unlisted := &papi.GetCPCodesResponse{ContractID: "ctr_1", GroupID: "grp_1"}
+	listed := &papi.GetCPCodesResponse{
+		ContractID: "ctr_1",
+		GroupID:    "grp_1",
+		CPCodes:    papi.CPCodeItems{Items: []papi.CPCode{{ID: "cpc_123", Name: "test cpcode", ProductIDs: []string{"prd_1"}}}},
+	}
+
+	t.Run("CP code listed after a few polls", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetCPCodes", AnyCTX, req).Return(unlisted, nil).Times(3)
+		client.On("GetCPCodes", AnyCTX, req).Return(listed, nil).Once()
+
+		useClient(client, func() {
+			err := waitForCPCode(context.Background(), "cpc_123", "ctr_1", "grp_1", testMeta{})
+			assert.NoError(t, err)
+		})
+		client.AssertExpectations(t)
+		client.AssertNumberOfCalls(t, "GetCPCodes", 4)
+	})
+
+	t.Run("timeout while CP code is not listed", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetCPCodes", AnyCTX, req).Return(unlisted, nil)
+
+		useClient(client, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			err := waitForCPCode(ctx, "cpc_123", "ctr_1", "grp_1", testMeta{})
+			assert.True(t, errors.Is(err, ErrLookingUpCPCode))
+			assert.Contains(t, err.Error(), "CP code cpc_123 was created but is not listed yet")
+			assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+		})
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error looking up CP code", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetCPCodes", AnyCTX, req).Return(nil, fmt.Errorf("oops"))
+
+		useClient(client, func() {
+			err := waitForCPCode(context.Background(), "cpc_123", "ctr_1", "grp_1", testMeta{})
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "oops")
+		})
+		client.AssertExpectations(t)
+		client.AssertNumberOfCalls(t, "GetCPCodes", 1)
+	})
+}
+
+func TestResourceCPCodeDelete(t *testing.T) {
+	client := &mockpapi{}
+	useClient(client, func() {
+		state := &terraform.InstanceState{
+			ID: "cpc_123",
+			Attributes: map[string]string{
+				"id":       "cpc_123",
+				"name":     "test cpcode",
+				"contract": "ctr_1",
+				"group":    "grp_1",
+				"product":  "prd_1",
+			},
+		}
+
+		newState, diags := resourceCPCode().Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, testMeta{})
+
+		assert.Nil(t, newState, "CP code should be removed from state")
+		assert.Equal(t, diag.Diagnostics{DiagWarnCPCodeNotDeleted}, diags)
+	})
+	client.AssertExpectations(t)
+}
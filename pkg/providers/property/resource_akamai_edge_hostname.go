@@ -223,7 +223,7 @@ func resourceSecureEdgeHostNameDelete(_ context.Context, d *schema.ResourceData,
 	logger.Info("PAPI does not support edge hostname deletion - resource will only be removed from state")
 	d.SetId("")
 	logger.Debugf("DONE")
-	return nil
+	return diag.Diagnostics{DiagWarnEdgeHostnameNotDeleted}
 }
 
 func resourceSecureEdgeHostNameImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
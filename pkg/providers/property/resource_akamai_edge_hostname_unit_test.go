@@ -1,11 +1,13 @@
 package property
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
@@ -741,3 +743,26 @@ func TestSuppressEdgeHostnameDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceEdgeHostnameDelete(t *testing.T) {
+	client := &mockpapi{}
+	useClient(client, func() {
+		state := &terraform.InstanceState{
+			ID: "eh_1",
+			Attributes: map[string]string{
+				"id":            "eh_1",
+				"contract":      "ctr_2",
+				"group":         "grp_2",
+				"product":       "prd_2",
+				"edge_hostname": "test.akamaized.net",
+				"ip_behavior":   "IPV6_COMPLIANCE",
+			},
+		}
+
+		newState, diags := resourceSecureEdgeHostName().Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, testMeta{})
+
+		assert.Nil(t, newState, "edge hostname should be removed from state")
+		assert.Equal(t, diag.Diagnostics{DiagWarnEdgeHostnameNotDeleted}, diags)
+	})
+	client.AssertExpectations(t)
+}
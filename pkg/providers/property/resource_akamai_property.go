@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
@@ -22,6 +24,11 @@ import (
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
 )
 
+const (
+	versionBaseLatest     = "LATEST"
+	versionBaseProduction = "PRODUCTION"
+)
+
 func resourceProperty() *schema.Resource {
 	papiError := func() *schema.Resource {
 		return &schema.Resource{Schema: map[string]*schema.Schema{
@@ -54,6 +61,12 @@ func resourceProperty() *schema.Resource {
 			return old == new
 		}
 
+		if oldNormalized, err := NormalizeRules(old); err == nil {
+			if newNormalized, err := NormalizeRules(new); err == nil && oldNormalized == newNormalized {
+				return true
+			}
+		}
+
 		var oldRules, newRules papi.RulesUpdate
 		if err := json.Unmarshal([]byte(old), &oldRules); err != nil {
 			logger.Errorf("Unable to unmarshal 'old' JSON rules: %s", err)
@@ -76,6 +89,8 @@ func resourceProperty() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			hostNamesCustomDiff,
 			computedValuesCustomDiff,
+			ruleFormatCustomDiff,
+			rulesValidationCustomDiff,
 		),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourcePropertyImport,
@@ -145,25 +160,61 @@ func resourceProperty() *schema.Resource {
 			},
 
 			// Optional
-			"rule_format": {
-				Type:        schema.TypeString,
+			"clone_from": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Computed:    true,
-				Description: "Specify the rule format version (defaults to latest version available when created)",
-				ValidateDiagFunc: func(v interface{}, _ cty.Path) diag.Diagnostics {
-					format := v.(string)
-					if format == "" || format == "latest" {
-						return nil
-					}
-
-					if !regexp.MustCompile(`^v[0-9]{4}-[0-9]{2}-[0-9]{2}$`).MatchString(format) {
-						url := "https://developer.akamai.com/api/core_features/property_manager/vlatest.html#behaviors"
-						return diag.Errorf(`"rule_format" must be of the form vYYYY-MM-DD (with a leading "v") see %s`, url)
-					}
-
-					return nil
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Property version to clone the rule tree, and optionally the hostnames, from when creating the property",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							StateFunc:        addPrefixToState("prp_"),
+							ValidateDiagFunc: tools.IsNotBlank,
+						},
+						"version": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"copy_hostnames": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
 				},
 			},
+			"validate_rules": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate changed rules against PAPI during plan (applies to existing properties only)",
+			},
+			"version_notes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Notes to set on the property versions created or updated by Terraform",
+			},
+			"version_base": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      versionBaseLatest,
+				ValidateFunc: validation.StringInSlice([]string{versionBaseLatest, versionBaseProduction}, false),
+				Description:  "Version new edits are based on, either LATEST or PRODUCTION (the version active in production)",
+			},
+			"rule_format": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Specify the rule format version (defaults to latest version available when created)",
+				ValidateDiagFunc: validateRuleFormat,
+			},
 			"rules": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -172,9 +223,8 @@ func resourceProperty() *schema.Resource {
 				ValidateDiagFunc: validateRules,
 				DiffSuppressFunc: diffSuppressRules,
 				StateFunc: func(v interface{}) string {
-					var js string
-					if json.Unmarshal([]byte(v.(string)), &js) == nil {
-						return compactJSON([]byte(v.(string)))
+					if normalized, err := NormalizeRules(v.(string)); err == nil {
+						return normalized
 					}
 					return v.(string)
 				},
@@ -208,12 +258,30 @@ func resourceProperty() *schema.Resource {
 							Type:     schema.TypeString,
 							Required: true,
 							ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
-								if len(i.(string)) == 0 {
+								switch i.(string) {
+								case "":
 									return diag.Errorf("'cert_provisioning_type' cannot be empty when hostnames block is defined - See new hostnames schema")
+								case certTypeCPSManaged, certTypeDefault:
+									return nil
 								}
-								return nil
+								return diag.Errorf("'cert_provisioning_type' must be either %q or %q", certTypeCPSManaged, certTypeDefault)
 							},
 						},
+						"auto_create_edge_hostname": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Create the edge hostname given in cname_to when it doesn't exist",
+						},
+						"ip_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  papi.EHIPVersionV6Compliance,
+							ValidateFunc: validation.StringInSlice([]string{
+								papi.EHIPVersionV4, papi.EHIPVersionV6Performance, papi.EHIPVersionV6Compliance,
+							}, false),
+							Description: "IP version behavior of an automatically created edge hostname",
+						},
 						"cname_type": {
 							Type:     schema.TypeString,
 							Optional: true,
@@ -345,6 +413,95 @@ func computedValuesCustomDiff(_ context.Context, d *schema.ResourceDiff, m inter
 	}
 	return nil
 }
+
+// ruleFormatCustomDiff makes sure a frozen rule format exists before any property version is created with it
+func ruleFormatCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "ruleFormatCustomDiff")
+
+	if !d.HasChange("rule_format") || !d.NewValueKnown("rule_format") {
+		return nil
+	}
+	format := d.Get("rule_format").(string)
+	if format == "" || format == "latest" {
+		return nil
+	}
+
+	ruleFormats, err := inst.Client(meta).GetRuleFormats(ctx)
+	if err != nil {
+		logger.Warnf("unable to verify rule format %q: %s", format, err)
+		return nil
+	}
+	for _, available := range ruleFormats.RuleFormats.Items {
+		if available == format {
+			return nil
+		}
+	}
+
+	logger.Errorf("rule format %q is not available", format)
+	return fmt.Errorf("%w: %q, must be one of: %s", ErrRuleFormatNotAvailable, format, strings.Join(ruleFormats.RuleFormats.Items, ", "))
+}
+
+// rulesValidationCustomDiff submits changed rules of an existing property to PAPI in dry run mode, so that rule errors
+// are reported during plan instead of after a new property version has been created
+func rulesValidationCustomDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "rulesValidationCustomDiff")
+
+	if !d.Get("validate_rules").(bool) || d.Id() == "" || !d.HasChange("rules") || !d.NewValueKnown("rules") {
+		return nil
+	}
+	rulesJSON := d.Get("rules").(string)
+	if rulesJSON == "" {
+		return nil
+	}
+
+	var rules papi.RulesUpdate
+	if err := json.Unmarshal([]byte(rulesJSON), &rules); err != nil {
+		return fmt.Errorf("rules are not valid JSON: %s", err)
+	}
+
+	// latest_version is always marked as computed in the plan, so the version from the state is used
+	version, _ := d.GetChange("latest_version")
+	req := papi.UpdateRulesRequest{
+		PropertyID:      d.Id(),
+		PropertyVersion: version.(int),
+		ContractID:      tools.AddPrefix(d.Get("contract_id").(string), "ctr_"),
+		GroupID:         tools.AddPrefix(d.Get("group_id").(string), "grp_"),
+		DryRun:          true,
+		ValidateMode:    papi.RuleValidateModeFull,
+		ValidateRules:   true,
+		Rules:           rules,
+	}
+
+	if ruleFormat := d.Get("rule_format").(string); ruleFormat != "" && d.NewValueKnown("rule_format") {
+		h := http.Header{
+			"Content-Type": []string{fmt.Sprintf("application/vnd.akamai.papirules.%s+json", ruleFormat)},
+		}
+		ctx = session.ContextWithOptions(ctx, session.WithContextHeaders(h))
+	}
+
+	logger.Debugf("validating rules of property %s version %d", req.PropertyID, req.PropertyVersion)
+	res, err := inst.Client(meta).UpdateRuleTree(ctx, req)
+	if err != nil {
+		logger.WithError(err).Error("could not validate property rules")
+		return fmt.Errorf("%w: %s", ErrRulesValidation, err)
+	}
+	if len(res.Errors) == 0 {
+		return nil
+	}
+
+	msgs := make([]string, 0, len(res.Errors))
+	for _, ruleErr := range res.Errors {
+		msg := fmt.Sprintf("%s: %s", ruleErr.Title, ruleErr.Detail)
+		if ruleErr.BehaviorName != "" {
+			msg = fmt.Sprintf("%s (behavior %q)", msg, ruleErr.BehaviorName)
+		}
+		msgs = append(msgs, msg)
+	}
+	return fmt.Errorf("%w:\n%s", ErrRulesValidation, strings.Join(msgs, "\n"))
+}
+
 func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	meta := akamai.Meta(m)
 	logger := meta.Log("PAPI", "resourcePropertyCreate")
@@ -386,7 +543,17 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 
 	RulesJSON := []byte(d.Get("rules").(string))
 
-	PropertyID, err := createProperty(ctx, client, PropertyName, GroupID, ContractID, ProductID, RuleFormat)
+	var CloneFrom *papi.PropertyCloneFrom
+	if cloneFromList, ok := d.Get("clone_from").([]interface{}); ok && len(cloneFromList) > 0 && cloneFromList[0] != nil {
+		cloneFromMap := cloneFromList[0].(map[string]interface{})
+		CloneFrom = &papi.PropertyCloneFrom{
+			PropertyID:    tools.AddPrefix(cloneFromMap["property_id"].(string), "prp_"),
+			Version:       cloneFromMap["version"].(int),
+			CopyHostnames: cloneFromMap["copy_hostnames"].(bool),
+		}
+	}
+
+	PropertyID, err := createProperty(ctx, client, PropertyName, GroupID, ContractID, ProductID, RuleFormat, CloneFrom)
 	if err != nil {
 		if strings.Contains(err.Error(), "\"statusCode\": 404") {
 			// find out what is missing from the request
@@ -437,6 +604,9 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 	if err == nil {
 		Hostnames := mapToHostnames(HostnameVal)
 		if len(Hostnames) > 0 {
+			if err := createMissingEdgeHostnames(ctx, client, Property, HostnameVal); err != nil {
+				return diag.FromErr(err)
+			}
 			if err := updatePropertyHostnames(ctx, client, Property, Hostnames); err != nil {
 				return diag.FromErr(err)
 			}
@@ -451,6 +621,9 @@ func resourcePropertyCreate(ctx context.Context, d *schema.ResourceData, m inter
 			logger.WithError(err).Error("failed to unmarshal property rules")
 			return diag.Errorf("rules are not valid JSON: %s", err)
 		}
+		if VersionNotes, ok := d.GetOk("version_notes"); ok {
+			Rules.Comments = VersionNotes.(string)
+		}
 
 		ctx := ctx
 		if RuleFormat != "" {
@@ -495,17 +668,39 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		ProductionVersion = *Property.ProductionVersion
 	}
 
-	// TODO: Load hostnames asynchronously
-	Hostnames, err := fetchPropertyHostnames(ctx, client, *Property)
-	if err != nil {
-		return diag.FromErr(err)
+	// Hostnames, rules and version details only depend on the property, so load them concurrently
+	var (
+		wg                       sync.WaitGroup
+		Hostnames                []papi.Hostname
+		Rules                    papi.RulesUpdate
+		RuleFormat               string
+		RuleErrors, RuleWarnings []*papi.Error
+		VersionRes               *papi.GetPropertyVersionsResponse
+		HostnamesErr, RulesErr   error
+		VersionErr               error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		Hostnames, HostnamesErr = fetchPropertyHostnames(ctx, client, *Property)
+	}()
+	go func() {
+		defer wg.Done()
+		Rules, RuleFormat, RuleErrors, RuleWarnings, RulesErr = fetchPropertyRules(ctx, client, *Property)
+	}()
+	go func() {
+		defer wg.Done()
+		VersionRes, VersionErr = fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, Property.LatestVersion)
+	}()
+	wg.Wait()
+
+	for _, err := range []error{HostnamesErr, RulesErr, VersionErr} {
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
+	Property.ProductID = VersionRes.Version.ProductID
 
-	// TODO: Load rules asynchronously
-	Rules, RuleFormat, RuleErrors, RuleWarnings, err := fetchPropertyRules(ctx, client, *Property)
-	if err != nil {
-		return diag.FromErr(err)
-	}
 	if len(RuleErrors) > 0 {
 		if err := d.Set("rule_errors", papiErrorsToList(RuleErrors)); err != nil {
 			return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
@@ -524,17 +719,21 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		logger.Warnf("Property has rule warnings %s", msg)
 	}
 
+	// Version notes are returned as the rule tree comments, they are not part of the configured rules
+	if VersionNotes, ok := d.GetOk("version_notes"); ok && Rules.Comments == VersionNotes.(string) {
+		Rules.Comments = ""
+	}
+
 	RulesJSON, err := json.Marshal(Rules)
 	if err != nil {
 		logger.WithError(err).Error("could not render rules as JSON")
 		return diag.Errorf("received rules that could not be rendered to JSON: %s", err)
 	}
-	PropertyVersion := Property.LatestVersion
-	res, err := fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, PropertyVersion)
+	NormalizedRules, err := NormalizeRules(string(RulesJSON))
 	if err != nil {
-		return diag.FromErr(err)
+		logger.WithError(err).Error("could not normalize rules")
+		return diag.Errorf("received rules that could not be normalized: %s", err)
 	}
-	Property.ProductID = res.Version.ProductID
 
 	attrs := map[string]interface{}{
 		"name":               Property.PropertyName,
@@ -545,8 +744,8 @@ func resourcePropertyRead(ctx context.Context, d *schema.ResourceData, m interfa
 		"latest_version":     Property.LatestVersion,
 		"staging_version":    StagingVersion,
 		"production_version": ProductionVersion,
-		"hostnames":          flattenHostnames(Hostnames),
-		"rules":              string(RulesJSON),
+		"hostnames":          keepHostnameOptions(flattenHostnames(Hostnames), d.Get("hostnames").([]interface{})),
+		"rules":              NormalizedRules,
 		"rule_format":        RuleFormat,
 		"rule_errors":        papiErrorsToList(RuleErrors),
 	}
@@ -631,14 +830,35 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	GroupID := d.Get("group_id").(string)
 	PropertyVersion := Property.LatestVersion
 
-	resp, err := fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, PropertyVersion)
-
-	if err != nil {
-		d.Partial(true)
-		return diag.FromErr(err)
+	// Branching from production means the new version doesn't carry what's in the latest version, so hostnames and
+	// rules have to be written in full
+	Rebased := d.Get("version_base").(string) == versionBaseProduction && ProductionVersion != nil && *ProductionVersion != PropertyVersion
+
+	// State was refreshed before the update, so an active latest version doesn't need another lookup
+	LatestIsActive := (StagingVersion != nil && *StagingVersion == PropertyVersion) ||
+		(ProductionVersion != nil && *ProductionVersion == PropertyVersion)
+	if Rebased {
+		BaseProperty := Property
+		BaseProperty.LatestVersion = *ProductionVersion
+		VersionID, err := createPropertyVersion(ctx, client, BaseProperty)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		logger.Debugf("created version %d based on production version %d", VersionID, *ProductionVersion)
+		Property.LatestVersion = VersionID
+		LatestIsActive = false
+	} else if !LatestIsActive {
+		resp, err := fetchPropertyVersion(ctx, client, PropertyID, GroupID, ContractID, PropertyVersion)
+		if err != nil {
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+		LatestIsActive = resp.Version.ProductionStatus != papi.VersionStatusInactive || resp.Version.StagingStatus != papi.VersionStatusInactive
 	}
+
 	// check latest version is editable
-	if resp.Version.ProductionStatus != papi.VersionStatusInactive || resp.Version.StagingStatus != papi.VersionStatusInactive {
+	if LatestIsActive {
 		// The latest version has been activated on either production or staging, so we need to create a new version to apply changes on
 		VersionID, err := createPropertyVersion(ctx, client, Property)
 		if err != nil {
@@ -649,11 +869,15 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	// Hostnames
-	if d.HasChange("hostnames") {
+	if d.HasChange("hostnames") || Rebased {
 		HostnameVal, err := tools.GetInterfaceArrayValue("hostnames", d)
 		if err == nil {
 			Hostnames := mapToHostnames(HostnameVal)
 			if len(Hostnames) > 0 {
+				if err := createMissingEdgeHostnames(ctx, client, Property, HostnameVal); err != nil {
+					d.Partial(true)
+					return diag.FromErr(err)
+				}
 				if err := updatePropertyHostnames(ctx, client, Property, Hostnames); err != nil {
 					d.Partial(true)
 					return diag.FromErr(err)
@@ -666,7 +890,10 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 
 	RuleFormat := d.Get("rule_format").(string)
 	RulesJSON := []byte(d.Get("rules").(string))
-	RulesNeedUpdate := len(RulesJSON) > 0 && d.HasChange("rules")
+	VersionNotes := d.Get("version_notes").(string)
+	// Notes are kept in the rule tree, so a new version only gets them when its rules are written
+	NotesNeedUpdate := VersionNotes != "" && Property.LatestVersion != PropertyVersion
+	RulesNeedUpdate := len(RulesJSON) > 0 && (d.HasChange("rules") || Rebased || NotesNeedUpdate)
 	FormatNeedsUpdate := len(RuleFormat) > 0 && d.HasChange("rule_format")
 
 	if FormatNeedsUpdate || RulesNeedUpdate {
@@ -675,6 +902,9 @@ func resourcePropertyUpdate(ctx context.Context, d *schema.ResourceData, m inter
 			d.Partial(true)
 			return diag.Errorf("rules are not valid JSON: %s", err)
 		}
+		if VersionNotes != "" {
+			Rules.Comments = VersionNotes
+		}
 
 		MIME := fmt.Sprintf("application/vnd.akamai.papirules.%s+json", RuleFormat)
 		h := http.Header{"Content-Type": []string{MIME}}
@@ -730,8 +960,10 @@ func resourcePropertyImport(ctx context.Context, d *schema.ResourceData, m inter
 	// no need to fetch anything if the user gave both GroupID and ContractID
 	if GroupID != "" && ContractID != "" {
 		attrs := map[string]interface{}{
-			"group_id":    GroupID,
-			"contract_id": ContractID,
+			"group_id":       GroupID,
+			"contract_id":    ContractID,
+			"validate_rules": false,
+			"version_base":   versionBaseLatest,
 		}
 		if err := rdSetAttrs(ctx, d, attrs); err != nil {
 			return nil, err
@@ -749,8 +981,10 @@ func resourcePropertyImport(ctx context.Context, d *schema.ResourceData, m inter
 	}
 
 	attrs := map[string]interface{}{
-		"group_id":    Property.GroupID,
-		"contract_id": Property.ContractID,
+		"group_id":       Property.GroupID,
+		"contract_id":    Property.ContractID,
+		"validate_rules": false,
+		"version_base":   versionBaseLatest,
 	}
 	if err := rdSetAttrs(ctx, d, attrs); err != nil {
 		return nil, err
@@ -770,11 +1004,27 @@ func resPropForbiddenAttrs() []string {
 	}
 }
 
-func createProperty(ctx context.Context, client papi.PAPI, PropertyName, GroupID, ContractID, ProductID, RuleFormat string) (PropertyID string, err error) {
+// validateRuleFormat checks that a rule format is either "latest" or a frozen vYYYY-MM-DD version
+func validateRuleFormat(v interface{}, _ cty.Path) diag.Diagnostics {
+	format := v.(string)
+	if format == "" || format == "latest" {
+		return nil
+	}
+
+	if !regexp.MustCompile(`^v[0-9]{4}-[0-9]{2}-[0-9]{2}$`).MatchString(format) {
+		url := "https://developer.akamai.com/api/core_features/property_manager/vlatest.html#behaviors"
+		return diag.Errorf(`"rule_format" must be of the form vYYYY-MM-DD (with a leading "v") see %s`, url)
+	}
+
+	return nil
+}
+
+func createProperty(ctx context.Context, client papi.PAPI, PropertyName, GroupID, ContractID, ProductID, RuleFormat string, CloneFrom *papi.PropertyCloneFrom) (PropertyID string, err error) {
 	req := papi.CreatePropertyRequest{
 		ContractID: ContractID,
 		GroupID:    GroupID,
 		Property: papi.PropertyCreate{
+			CloneFrom:    CloneFrom,
 			ProductID:    ProductID,
 			PropertyName: PropertyName,
 			RuleFormat:   RuleFormat,
@@ -1016,6 +1266,90 @@ func mapToHostnames(givenList []interface{}) []papi.Hostname {
 	return Hostnames
 }
 
+// Create the edge hostnames of the given hostnames which have auto_create_edge_hostname set and don't exist yet
+func createMissingEdgeHostnames(ctx context.Context, client papi.PAPI, Property papi.Property, givenList []interface{}) error {
+	var auto []map[string]interface{}
+	for _, given := range givenList {
+		if r, ok := given.(map[string]interface{}); ok && r["auto_create_edge_hostname"] == true {
+			auto = append(auto, r)
+		}
+	}
+	if len(auto) == 0 {
+		return nil
+	}
+
+	logger := log.FromContext(ctx)
+	res, err := client.GetEdgeHostnames(ctx, papi.GetEdgeHostnamesRequest{
+		ContractID: Property.ContractID,
+		GroupID:    Property.GroupID,
+	})
+	if err != nil {
+		logger.WithError(err).Error("could not fetch edge hostnames")
+		return err
+	}
+	existing := map[string]bool{}
+	for _, ehn := range res.EdgeHostnames.Items {
+		existing[ehn.Domain] = true
+	}
+
+	for _, r := range auto {
+		cnameTo := r["cname_to"].(string)
+		if existing[cnameTo] {
+			continue
+		}
+
+		newHostname := papi.EdgeHostnameCreate{
+			ProductID:         Property.ProductID,
+			IPVersionBehavior: r["ip_behavior"].(string),
+		}
+		switch {
+		case strings.HasSuffix(cnameTo, ".edgesuite.net"):
+			newHostname.DomainSuffix = "edgesuite.net"
+			newHostname.SecureNetwork = papi.EHSecureNetworkStandardTLS
+		case strings.HasSuffix(cnameTo, ".akamaized.net"):
+			newHostname.DomainSuffix = "akamaized.net"
+			newHostname.SecureNetwork = papi.EHSecureNetworkSharedCert
+		default:
+			return fmt.Errorf("%w: %s", ErrEdgeHostnameAutoCreate, cnameTo)
+		}
+		newHostname.DomainPrefix = strings.TrimSuffix(cnameTo, "."+newHostname.DomainSuffix)
+
+		logger.Debugf("creating edge hostname %s", cnameTo)
+		if _, err := client.CreateEdgeHostname(ctx, papi.CreateEdgeHostnameRequest{
+			ContractID:   Property.ContractID,
+			GroupID:      Property.GroupID,
+			EdgeHostname: newHostname,
+		}); err != nil {
+			logger.WithError(err).Error("could not create edge hostname")
+			return err
+		}
+		existing[cnameTo] = true
+	}
+
+	return nil
+}
+
+// Copy the configuration-only options of the hostnames block, which PAPI doesn't return, to the hostnames read
+func keepHostnameOptions(hostnames []map[string]interface{}, givenList []interface{}) []map[string]interface{} {
+	given := map[string]map[string]interface{}{}
+	for _, g := range givenList {
+		if r, ok := g.(map[string]interface{}); ok {
+			given[r["cname_from"].(string)] = r
+		}
+	}
+
+	for _, hn := range hostnames {
+		hn["auto_create_edge_hostname"] = false
+		hn["ip_behavior"] = papi.EHIPVersionV6Compliance
+		if r, ok := given[hn["cname_from"].(string)]; ok {
+			hn["auto_create_edge_hostname"] = r["auto_create_edge_hostname"]
+			hn["ip_behavior"] = r["ip_behavior"]
+		}
+	}
+
+	return hostnames
+}
+
 // Set many attributes of a schema.ResourceData in one call
 func rdSetAttrs(ctx context.Context, d *schema.ResourceData, AttributeValues map[string]interface{}) error {
 	logger := log.FromContext(ctx)
@@ -8,8 +8,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/spf13/cast"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
@@ -26,6 +28,9 @@ func resourcePropertyActivation() *schema.Resource {
 		DeleteContext: resourcePropertyActivationDelete,
 		Schema:        akamaiPropertyActivationSchema,
 		Timeouts: &schema.ResourceTimeout{
+			Create:  &PropertyResourceTimeout,
+			Update:  &PropertyResourceTimeout,
+			Delete:  &PropertyResourceTimeout,
 			Default: &PropertyResourceTimeout,
 		},
 	}
@@ -91,6 +96,47 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 		Default:     true,
 		Description: "automatically acknowledge all rule warnings for activation to continue. default is true",
 	},
+	"acknowledge_warnings": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "message IDs of the rule warnings to acknowledge when auto_acknowledge_rule_warnings is set. Any other warning fails the activation",
+	},
+	"cancel_pending_activation": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "cancel, or wait for, an activation of another version still pending on the network before activating. default is false",
+	},
+	"wait_for_default_certs": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "after activating, wait until the certificates of hostnames with DEFAULT cert provisioning type are deployed to the network. default is false",
+	},
+	"note": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "note to attach to the activation, shown in the activation history",
+	},
+	"wait_for_deployment": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "wait until the activation is deployed to the network. If false, the activation is only submitted. default is true",
+	},
+	"poll_interval": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(10),
+		Description:  "interval in seconds for polling the activation status. default is 60",
+	},
+	"deactivate_on_destroy": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "deactivate the property on the network when the resource is destroyed. If false, the resource is only removed from state. default is true",
+	},
 	"version": {
 		Type:             schema.TypeInt,
 		Required:         true,
@@ -112,6 +158,27 @@ var akamaiPropertyActivationSchema = map[string]*schema.Schema{
 	},
 }
 
+// activationWarningsAcknowledgement returns whether all rule warnings are acknowledged for an activation,
+// or the message IDs of the only warnings to acknowledge
+func activationWarningsAcknowledgement(d *schema.ResourceData) (bool, []string, error) {
+	// Schema guarantees these types
+	if !d.Get("auto_acknowledge_rule_warnings").(bool) {
+		return false, nil, nil
+	}
+	warningsSet, err := tools.GetSetValue("acknowledge_warnings", d)
+	if errors.Is(err, tools.ErrNotFound) {
+		return true, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	var warnings []string
+	for _, warning := range warningsSet.List() {
+		warnings = append(warnings, cast.ToString(warning))
+	}
+	return false, warnings, nil
+}
+
 func papiError() *schema.Resource {
 	return &schema.Resource{Schema: map[string]*schema.Schema{
 		"type":           {Type: schema.TypeString, Optional: true},
@@ -158,8 +225,10 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
+	acknowledgeAllWarnings, acknowledgeWarnings, err := activationWarningsAcknowledgement(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// check to see if this tree has any issues
 	rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
@@ -212,6 +281,11 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 
 	// we create a new property activation in case of no previous activation, or deleted activation
 	if activation == nil || activation.ActivationType == papi.ActivationTypeDeactivate {
+		if d.Get("cancel_pending_activation").(bool) {
+			if err := resolvePendingActivation(ctx, logger, client, propertyID, version, network); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		notifySet, err := tools.GetSetValue("contact", d)
 		if err != nil {
 			return diag.FromErr(err)
@@ -228,7 +302,9 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 				Network:                network,
 				PropertyVersion:        version,
 				NotifyEmails:           notify,
-				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+				Note:                   d.Get("note").(string),
+				AcknowledgeAllWarnings: acknowledgeAllWarnings,
+				AcknowledgeWarnings:    acknowledgeWarnings,
 			},
 		})
 		if err != nil {
@@ -265,8 +341,12 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		if activation.Status == papi.ActivationStatusFailed {
 			return diag.FromErr(fmt.Errorf("activation request failed in downstream system"))
 		}
+		if !d.Get("wait_for_deployment").(bool) {
+			logger.Infof("not waiting for activation %s to be deployed, current status: %s", activation.ActivationID, activation.Status)
+			break
+		}
 		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+		case <-time.After(activationPollInterval(d)):
 			act, err := client.GetActivation(ctx, papi.GetActivationRequest{
 				ActivationID: activation.ActivationID,
 				PropertyID:   propertyID,
@@ -286,6 +366,12 @@ func resourcePropertyActivationCreate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.Get("wait_for_default_certs").(bool) {
+		if diags := waitForDefaultCerts(ctx, logger, client, propertyID, version, network); diags != nil {
+			return diags
+		}
+	}
+
 	if err := d.Set("version", activation.PropertyVersion); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
@@ -316,6 +402,12 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 		session.WithContextLog(logger),
 	)
 
+	if !d.Get("deactivate_on_destroy").(bool) {
+		logger.Infof("property %s stays active, removing activation from state only", d.Get("property_id"))
+		d.SetId("")
+		return diag.Diagnostics{DiagWarnActivationNotDeactivated}
+	}
+
 	network, err := networkAlias(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -334,8 +426,10 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
+	acknowledgeAllWarnings, acknowledgeWarnings, err := activationWarningsAcknowledgement(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	activation, err := lookupActivation(ctx, client, lookupActivationRequest{
 		propertyID: propertyID,
@@ -367,7 +461,9 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 				Network:                network,
 				PropertyVersion:        version,
 				NotifyEmails:           notify,
-				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+				Note:                   d.Get("note").(string),
+				AcknowledgeAllWarnings: acknowledgeAllWarnings,
+				AcknowledgeWarnings:    acknowledgeWarnings,
 			},
 		})
 		if err != nil {
@@ -407,8 +503,12 @@ func resourcePropertyActivationDelete(ctx context.Context, d *schema.ResourceDat
 		if activation.Status == papi.ActivationStatusFailed {
 			return diag.FromErr(fmt.Errorf("deactivation request failed in downstream system"))
 		}
+		if !d.Get("wait_for_deployment").(bool) {
+			logger.Infof("not waiting for deactivation %s to be deployed, current status: %s", activation.ActivationID, activation.Status)
+			break
+		}
 		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+		case <-time.After(activationPollInterval(d)):
 			act, err := client.GetActivation(ctx, papi.GetActivationRequest{
 				ActivationID: activation.ActivationID,
 				PropertyID:   propertyID,
@@ -565,8 +665,10 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	// Schema guarantees these types
-	acknowledgeRuleWarnings := d.Get("auto_acknowledge_rule_warnings").(bool)
+	acknowledgeAllWarnings, acknowledgeWarnings, err := activationWarningsAcknowledgement(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	// check to see if this tree has any issues
 	rules, err := client.GetRuleTree(ctx, papi.GetRuleTreeRequest{
@@ -617,6 +719,11 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if propertyActivation == nil {
+		if d.Get("cancel_pending_activation").(bool) {
+			if err := resolvePendingActivation(ctx, logger, client, propertyID, version, network); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 		notifySet, err := tools.GetSetValue("contact", d)
 		if err != nil {
 			return diag.FromErr(err)
@@ -633,7 +740,9 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 				Network:                network,
 				PropertyVersion:        version,
 				NotifyEmails:           notify,
-				AcknowledgeAllWarnings: acknowledgeRuleWarnings,
+				Note:                   d.Get("note").(string),
+				AcknowledgeAllWarnings: acknowledgeAllWarnings,
+				AcknowledgeWarnings:    acknowledgeWarnings,
 			},
 		})
 		if err != nil {
@@ -670,8 +779,12 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		if propertyActivation.Status == papi.ActivationStatusFailed {
 			return diag.FromErr(fmt.Errorf("activation request failed in downstream system"))
 		}
+		if !d.Get("wait_for_deployment").(bool) {
+			logger.Infof("not waiting for activation %s to be deployed, current status: %s", propertyActivation.ActivationID, propertyActivation.Status)
+			break
+		}
 		select {
-		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+		case <-time.After(activationPollInterval(d)):
 			act, err := client.GetActivation(ctx, papi.GetActivationRequest{
 				ActivationID: propertyActivation.ActivationID,
 				PropertyID:   propertyID,
@@ -693,6 +806,12 @@ func resourcePropertyActivationUpdate(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.Get("wait_for_default_certs").(bool) {
+		if diags := waitForDefaultCerts(ctx, logger, client, propertyID, version, network); diags != nil {
+			return diags
+		}
+	}
+
 	if err := d.Set("version", propertyActivation.PropertyVersion); err != nil {
 		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
 	}
@@ -784,6 +903,107 @@ func lookupActivation(ctx context.Context, client papi.PAPI, query lookupActivat
 	return nil, nil
 }
 
+// resolvePendingActivation clears the way for a new activation on the network, as PAPI rejects it while
+// an activation of another version is still pending. Pending activations are canceled where PAPI allows it,
+// activations already being deployed are waited for.
+func resolvePendingActivation(ctx context.Context, logger log.Interface, client papi.PAPI, propertyID string, version int, network papi.ActivationNetwork) error {
+	activations, err := client.GetActivations(ctx, papi.GetActivationsRequest{
+		PropertyID: propertyID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range activations.Activations.Items {
+		if a.Network != network || a.PropertyVersion == version || !isActivationPending(a.Status) {
+			continue
+		}
+
+		if a.Status == papi.ActivationStatusNew || a.Status == papi.ActivationStatusPending {
+			_, err := client.CancelActivation(ctx, papi.CancelActivationRequest{
+				PropertyID:   propertyID,
+				ActivationID: a.ActivationID,
+			})
+			if err == nil {
+				logger.Infof("canceled pending activation %s of version %d", a.ActivationID, a.PropertyVersion)
+				continue
+			}
+			logger.Warnf("unable to cancel pending activation %s, waiting for it to complete: %s", a.ActivationID, err)
+		}
+
+		activation := a
+		for isActivationPending(activation.Status) {
+			select {
+			case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+				act, err := client.GetActivation(ctx, papi.GetActivationRequest{
+					ActivationID: activation.ActivationID,
+					PropertyID:   propertyID,
+				})
+				if err != nil {
+					return err
+				}
+				activation = act.Activation
+
+			case <-ctx.Done():
+				return fmt.Errorf("waiting for pending activation %s: %w", activation.ActivationID, ctx.Err())
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForDefaultCerts polls the hostnames of an activated property version until all certificates
+// with the DEFAULT provisioning type are deployed to the network
+func waitForDefaultCerts(ctx context.Context, logger log.Interface, client papi.PAPI, propertyID string, version int, network papi.ActivationNetwork) diag.Diagnostics {
+	for {
+		hostnames, err := client.GetPropertyVersionHostnames(ctx, papi.GetPropertyVersionHostnamesRequest{
+			PropertyID:        propertyID,
+			PropertyVersion:   version,
+			IncludeCertStatus: true,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var pending []string
+		for _, hn := range hostnames.Hostnames.Items {
+			if hn.CertProvisioningType != certTypeDefault {
+				continue
+			}
+			statuses := hn.CertStatus.Staging
+			if network == papi.ActivationNetworkProduction {
+				statuses = hn.CertStatus.Production
+			}
+			if len(statuses) == 0 || statuses[0].Status != certStatusDeployed {
+				pending = append(pending, hn.CnameFrom)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		logger.Debugf("waiting for default certificates of %s to be deployed", strings.Join(pending, ", "))
+
+		select {
+		case <-time.After(tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)):
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return diag.Diagnostics{DiagWarnDefaultCertsTimeout}
+			}
+			return diag.FromErr(fmt.Errorf("waiting for default certificates: %w", ctx.Err()))
+		}
+	}
+}
+
+func isActivationPending(status papi.ActivationStatus) bool {
+	switch status {
+	case papi.ActivationStatusNew, papi.ActivationStatusPending,
+		papi.ActivationStatusZone1, papi.ActivationStatusZone2, papi.ActivationStatusZone3:
+		return true
+	}
+	return false
+}
+
 func networkAlias(d *schema.ResourceData) (papi.ActivationNetwork, error) {
 	network, err := tools.GetStringValue("network", d)
 	if err != nil {
@@ -808,3 +1028,11 @@ func networkAlias(d *schema.ResourceData) (papi.ActivationNetwork, error) {
 	}
 	return networkValue, nil
 }
+
+// activationPollInterval returns the interval for polling the status of an activation
+func activationPollInterval(d *schema.ResourceData) time.Duration {
+	if interval, ok := d.GetOk("poll_interval"); ok {
+		return time.Duration(interval.(int)) * time.Second
+	}
+	return tools.MaxDuration(ActivationPollInterval, ActivationPollMinimum)
+}
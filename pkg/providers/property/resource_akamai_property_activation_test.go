@@ -272,6 +272,130 @@ func TestResourcePropertyActivationCreate(t *testing.T) {
 		client.AssertExpectations(t)
 	})
 
+	t.Run("property stays active on destroy", func(t *testing.T) {
+		client := mockPAPIClient([]papiCall{
+			{
+				methodName: "GetRuleTree",
+				papiResponse: &papi.GetRuleTreeResponse{
+					Response: papi.Response{Errors: make([]*papi.Error, 0)},
+				},
+				error:    nil,
+				stubOnce: false,
+			},
+			{
+				methodName: "GetActivations",
+				papiResponse: &papi.GetActivationsResponse{
+					Activations: papi.ActivationsItems{Items: []*papi.Activation{{
+						AccountID:       "act_1-6JHGX",
+						ActivationID:    "atv_activation1",
+						ActivationType:  "ACTIVATE",
+						GroupID:         "grp_91533",
+						PropertyName:    "test",
+						PropertyID:      "prp_test",
+						PropertyVersion: 1,
+						Network:         "STAGING",
+						Status:          "ACTIVE",
+						SubmitDate:      "2020-10-28T15:04:05Z",
+					}}}},
+				error:    nil,
+				stubOnce: false,
+			},
+		})
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestPropertyActivation/ok/resource_property_activation_no_deactivation.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "id", "prp_test:STAGING"),
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "deactivate_on_destroy", "false"),
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "ACTIVE"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+		client.AssertNotCalled(t, "CreateActivation", mock.Anything, mock.Anything)
+	})
+
+	t.Run("activation is submitted without waiting for deployment", func(t *testing.T) {
+		pendingActivation := &papi.Activation{
+			ActivationID:    "atv_activation1",
+			ActivationType:  "ACTIVATE",
+			PropertyID:      "prp_test",
+			PropertyVersion: 1,
+			Network:         "STAGING",
+			Note:            "staging loop",
+			Status:          "PENDING",
+			SubmitDate:      "2020-10-28T15:04:05Z",
+		}
+		client := mockPAPIClient([]papiCall{
+			{
+				methodName: "GetRuleTree",
+				papiResponse: &papi.GetRuleTreeResponse{
+					Response: papi.Response{Errors: make([]*papi.Error, 0)},
+				},
+				stubOnce: false,
+			},
+			{
+				methodName:   "GetActivations",
+				papiResponse: &papi.GetActivationsResponse{},
+				stubOnce:     true,
+			},
+			{
+				methodName: "CreateActivation",
+				papiRequest: papi.CreateActivationRequest{
+					PropertyID: "prp_test",
+					Activation: papi.Activation{
+						ActivationType:         papi.ActivationTypeActivate,
+						Network:                papi.ActivationNetworkStaging,
+						PropertyVersion:        1,
+						NotifyEmails:           []string{"user@example.com"},
+						Note:                   "staging loop",
+						AcknowledgeAllWarnings: true,
+					},
+				},
+				papiResponse: &papi.CreateActivationResponse{ActivationID: "atv_activation1"},
+				stubOnce:     true,
+			},
+			{
+				methodName:   "GetActivation",
+				papiResponse: &papi.GetActivationResponse{Activation: pendingActivation},
+				stubOnce:     true,
+			},
+			{
+				methodName: "GetActivations",
+				papiResponse: &papi.GetActivationsResponse{
+					Activations: papi.ActivationsItems{Items: []*papi.Activation{pendingActivation}},
+				},
+				stubOnce: false,
+			},
+		})
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				IsUnitTest: true,
+				Providers:  testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestPropertyActivation/ok/resource_property_activation_no_wait.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "id", "prp_test:STAGING"),
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "note", "staging loop"),
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "activation_id", "atv_activation1"),
+							resource.TestCheckResourceAttr("akamai_property_activation.test", "status", "PENDING"),
+						),
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+
 	t.Run("check schema property activation - papi error", func(t *testing.T) {
 
 		client := mockPAPIClient([]papiCall{
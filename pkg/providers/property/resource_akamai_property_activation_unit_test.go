@@ -1,6 +1,7 @@
 package property
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+	"github.com/apex/log"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -346,3 +348,134 @@ func TestLookupActivation(t *testing.T) {
 		})
 	}
 }
+
+func TestActivationWarningsAcknowledgement(t *testing.T) {
+	tests := map[string]struct {
+		raw                map[string]interface{}
+		expectAll          bool
+		expectAcknowledged []string
+	}{
+		"default acknowledges all warnings": {
+			raw:       map[string]interface{}{},
+			expectAll: true,
+		},
+		"auto acknowledge disabled": {
+			raw: map[string]interface{}{
+				"auto_acknowledge_rule_warnings": false,
+				"acknowledge_warnings":           []interface{}{"msg_123"},
+			},
+			expectAll: false,
+		},
+		"allowlist acknowledges listed warnings only": {
+			raw: map[string]interface{}{
+				"acknowledge_warnings": []interface{}{"msg_123"},
+			},
+			expectAll:          false,
+			expectAcknowledged: []string{"msg_123"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, akamaiPropertyActivationSchema, test.raw)
+
+			all, acknowledged, err := activationWarningsAcknowledgement(d)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectAll, all)
+			assert.Equal(t, test.expectAcknowledged, acknowledged)
+		})
+	}
+}
+
+func TestResolvePendingActivation(t *testing.T) {
+	activations := &papi.GetActivationsResponse{
+		Activations: papi.ActivationsItems{Items: []*papi.Activation{
+			{
+				ActivationID:    "atv_1",
+				PropertyVersion: 1,
+				Network:         papi.ActivationNetworkStaging,
+				Status:          papi.ActivationStatusActive,
+			},
+			{
+				ActivationID:    "atv_2",
+				PropertyVersion: 2,
+				Network:         papi.ActivationNetworkStaging,
+				Status:          papi.ActivationStatusPending,
+			},
+			{
+				ActivationID:    "atv_3",
+				PropertyVersion: 2,
+				Network:         papi.ActivationNetworkProduction,
+				Status:          papi.ActivationStatusPending,
+			},
+			{
+				ActivationID:    "atv_4",
+				PropertyVersion: 3,
+				Network:         papi.ActivationNetworkStaging,
+				Status:          papi.ActivationStatusPending,
+			},
+		}},
+	}
+
+	t.Run("cancels pending activation of another version", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetActivations", mock.Anything, papi.GetActivationsRequest{PropertyID: "prp_1"}).Return(activations, nil)
+		client.On("CancelActivation", mock.Anything, papi.CancelActivationRequest{
+			PropertyID:   "prp_1",
+			ActivationID: "atv_2",
+		}).Return(&papi.CancelActivationResponse{}, nil).Once()
+
+		err := resolvePendingActivation(context.Background(), log.Log, client, "prp_1", 3, papi.ActivationNetworkStaging)
+		require.NoError(t, err)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error listing activations", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetActivations", mock.Anything, papi.GetActivationsRequest{PropertyID: "prp_1"}).Return(nil, errors.New("oops"))
+
+		err := resolvePendingActivation(context.Background(), log.Log, client, "prp_1", 3, papi.ActivationNetworkStaging)
+		assert.EqualError(t, err, "oops")
+		client.AssertExpectations(t)
+	})
+}
+
+func TestWaitForDefaultCerts(t *testing.T) {
+	req := papi.GetPropertyVersionHostnamesRequest{
+		PropertyID:        "prp_1",
+		PropertyVersion:   2,
+		IncludeCertStatus: true,
+	}
+
+	t.Run("default certificates deployed", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetPropertyVersionHostnames", mock.Anything, req).Return(&papi.GetPropertyVersionHostnamesResponse{
+			Hostnames: papi.HostnameResponseItems{Items: []papi.Hostname{
+				{
+					CnameFrom:            "www.example.com",
+					CertProvisioningType: certTypeDefault,
+					CertStatus: papi.CertStatusItem{
+						Staging:    []papi.StatusItem{{Status: certStatusDeployed}},
+						Production: []papi.StatusItem{{Status: "PENDING"}},
+					},
+				},
+				{
+					CnameFrom:            "cps.example.com",
+					CertProvisioningType: certTypeCPSManaged,
+				},
+			}},
+		}, nil).Once()
+
+		diags := waitForDefaultCerts(context.Background(), log.Log, client, "prp_1", 2, papi.ActivationNetworkStaging)
+		assert.Nil(t, diags)
+		client.AssertExpectations(t)
+	})
+
+	t.Run("error fetching hostnames", func(t *testing.T) {
+		client := &mockpapi{}
+		client.On("GetPropertyVersionHostnames", mock.Anything, req).Return(nil, errors.New("oops")).Once()
+
+		diags := waitForDefaultCerts(context.Background(), log.Log, client, "prp_1", 2, papi.ActivationNetworkProduction)
+		assert.True(t, diags.HasError())
+		client.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,109 @@
+package property
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/session"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/akamai"
+	"github.com/akamai/terraform-provider-akamai/v2/pkg/tools"
+)
+
+// clientSettingsID is the ID of the akamai_property_client_settings resource, client settings are account-wide
+const clientSettingsID = "client_settings"
+
+// PAPI Client Settings
+//
+// https://developer.akamai.com/api/core_features/property_manager/v1.html#clientsettings
+func resourcePropertyClientSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePropertyClientSettingsUpdate,
+		ReadContext:   resourcePropertyClientSettingsRead,
+		UpdateContext: resourcePropertyClientSettingsUpdate,
+		DeleteContext: resourcePropertyClientSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"rule_format": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateRuleFormat,
+				Description:      "Rule format used by default for new properties and rule trees, either latest or a frozen format",
+			},
+			"use_prefixes": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether PAPI returns IDs with their type prefixes by default",
+			},
+		},
+	}
+}
+
+func resourcePropertyClientSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyClientSettingsUpdate")
+	client := inst.Client(meta)
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	ruleFormat, err := tools.GetStringValue("rule_format", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	usePrefixes, err := tools.GetBoolValue("use_prefixes", d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logger.Debugf("updating client settings: rule format %q, use prefixes %t", ruleFormat, usePrefixes)
+	if _, err := client.UpdateClientSettings(ctx, papi.ClientSettingsBody{
+		RuleFormat:  ruleFormat,
+		UsePrefixes: usePrefixes,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clientSettingsID)
+
+	return resourcePropertyClientSettingsRead(ctx, d, m)
+}
+
+func resourcePropertyClientSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyClientSettingsRead")
+	client := inst.Client(meta)
+	ctx = session.ContextWithOptions(
+		ctx,
+		session.WithContextLog(logger),
+	)
+
+	settings, err := client.GetClientSettings(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("rule_format", settings.RuleFormat); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+	if err := d.Set("use_prefixes", settings.UsePrefixes); err != nil {
+		return diag.FromErr(fmt.Errorf("%w: %s", tools.ErrValueSet, err.Error()))
+	}
+
+	return nil
+}
+
+func resourcePropertyClientSettingsDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	meta := akamai.Meta(m)
+	logger := meta.Log("PAPI", "resourcePropertyClientSettingsDelete")
+	logger.Info("client settings cannot be deleted - resource will only be removed from state")
+	d.SetId("")
+	return diag.Diagnostics{DiagWarnClientSettingsNotReset}
+}
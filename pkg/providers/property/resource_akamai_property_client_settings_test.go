@@ -0,0 +1,61 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+func TestResClientSettings(t *testing.T) {
+	t.Run("create, update and import client settings", func(t *testing.T) {
+		client := &mockpapi{}
+		client.Test(T{t})
+
+		// GetClientSettings always returns the latest settings
+		var settings papi.ClientSettingsBody
+		for _, update := range []papi.ClientSettingsBody{
+			{RuleFormat: "v2020-11-02", UsePrefixes: true},
+			{RuleFormat: "latest", UsePrefixes: false},
+		} {
+			update := update
+			client.On("UpdateClientSettings", AnyCTX, update).Return(&update, nil).Once().Run(func(mock.Arguments) {
+				settings = update
+			})
+		}
+		client.On("GetClientSettings", AnyCTX).Return(&settings, nil)
+
+		useClient(client, func() {
+			resource.UnitTest(t, resource.TestCase{
+				Providers: testAccProviders,
+				Steps: []resource.TestStep{
+					{
+						Config: loadFixtureString("testdata/TestResClientSettings/create.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_client_settings.test", "id", "client_settings"),
+							resource.TestCheckResourceAttr("akamai_property_client_settings.test", "rule_format", "v2020-11-02"),
+							resource.TestCheckResourceAttr("akamai_property_client_settings.test", "use_prefixes", "true"),
+						),
+					},
+					{
+						Config: loadFixtureString("testdata/TestResClientSettings/update.tf"),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property_client_settings.test", "rule_format", "latest"),
+							resource.TestCheckResourceAttr("akamai_property_client_settings.test", "use_prefixes", "false"),
+						),
+					},
+					{
+						ImportState:       true,
+						ImportStateId:     "client_settings",
+						ResourceName:      "akamai_property_client_settings.test",
+						ImportStateVerify: true,
+					},
+				},
+			})
+		})
+
+		client.AssertExpectations(t)
+	})
+}
@@ -1,12 +1,15 @@
 package property
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/stretchr/testify/mock"
+	"github.com/tj/assert"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
 )
@@ -593,6 +596,191 @@ func TestResProperty(t *testing.T) {
 			client.AssertExpectations(t)
 		})
 
+		t.Run("property is cloned from another property version", func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+			State := &TestState{Client: client}
+
+			req := papi.CreatePropertyRequest{
+				ContractID: "ctr_0",
+				GroupID:    "grp_0",
+				Property: papi.PropertyCreate{
+					ProductID:    "prd_0",
+					PropertyName: "test property",
+					CloneFrom: &papi.PropertyCloneFrom{
+						PropertyID:    "prp_5",
+						Version:       3,
+						CopyHostnames: true,
+					},
+				},
+			}
+			client.On("CreateProperty", AnyCTX, req).Return(&papi.CreatePropertyResponse{PropertyID: "prp_0"}, nil).Run(func(mock.Arguments) {
+				State.Property = papi.Property{
+					PropertyName:  "test property",
+					PropertyID:    "prp_0",
+					GroupID:       "grp_0",
+					ContractID:    "ctr_0",
+					ProductID:     "prd_0",
+					LatestVersion: 1,
+				}
+				State.Rules = papi.RulesUpdate{Rules: papi.Rules{Name: "cloned"}}
+				State.RuleFormat = "v2020-01-01"
+			}).Once()
+
+			setup := ComposeBehaviors(
+				GetProperty("prp_0"),
+				GetVersionResources("prp_0", 1),
+				DeleteProperty("prp_0"),
+			)
+			setup(State)
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{{
+						Config: loadFixtureString("testdata/%s.tf", t.Name()),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property.test", "id", "prp_0"),
+							resource.TestCheckResourceAttr("akamai_property.test", "clone_from.0.property_id", "prp_5"),
+							resource.TestCheckResourceAttr("akamai_property.test", "clone_from.0.version", "3"),
+							resource.TestCheckResourceAttr("akamai_property.test", "rules", `{"rules":{"name":"cloned","options":{}}}`),
+						),
+					}},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+
+		t.Run("new version is based on production version", func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+			State := &TestState{Client: client}
+
+			setup := ComposeBehaviors(
+				PropertyLifecycle("test property", "prp_0", "grp_0"),
+				GetPropertyVersionResources("prp_0", 1, papi.VersionStatusInactive, papi.VersionStatusActive),
+				SetHostnames("prp_0", 1, "to.test.domain"),
+				GetVersionResources("prp_0", 2),
+				GetPropertyVersionResources("prp_0", 2, papi.VersionStatusInactive, papi.VersionStatusInactive),
+				AdvanceVersion("prp_0", 1, 3),
+				GetPropertyVersionResources("prp_0", 3, papi.VersionStatusInactive, papi.VersionStatusInactive),
+				SetHostnames("prp_0", 3, "to2.test.domain"),
+			)
+			setup(State)
+
+			forVersion := func(version int) interface{} {
+				return mock.MatchedBy(func(req papi.UpdateRulesRequest) bool { return req.PropertyVersion == version })
+			}
+			client.On("UpdateRuleTree", AnyCTX, forVersion(1)).Return(&papi.UpdateRulesResponse{}, nil).Maybe()
+			client.On("UpdateRuleTree", AnyCTX, forVersion(3)).Return(&papi.UpdateRulesResponse{}, nil).Once()
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:             loadFixtureString("testdata/%s/step0.tf", t.Name()),
+							Check:              CheckAttrs("prp_0", "to.test.domain", "1", "0", "0", "ehn_123"),
+							ExpectNonEmptyPlan: true,
+						},
+						{
+							PreConfig: func() {
+								// version 1 went to production and version 2 is a draft made outside of terraform
+								ProductionVersion := 1
+								State.Property.ProductionVersion = &ProductionVersion
+								State.Property.LatestVersion = 2
+							},
+							Config:             loadFixtureString("testdata/%s/step1.tf", t.Name()),
+							Check:              CheckAttrs("prp_0", "to2.test.domain", "3", "0", "1", "ehn_123"),
+							ExpectNonEmptyPlan: true,
+						},
+					},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+
+		t.Run("version notes are set on the rule tree", func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+			State := &TestState{Client: client}
+
+			setup := ComposeBehaviors(
+				PropertyLifecycle("test property", "prp_0", "grp_0"),
+				GetPropertyVersionResources("prp_0", 1, papi.VersionStatusInactive, papi.VersionStatusInactive),
+				SetHostnames("prp_0", 1, "to.test.domain"),
+			)
+			setup(State)
+
+			withNotes := mock.MatchedBy(func(req papi.UpdateRulesRequest) bool {
+				return req.PropertyVersion == 1 && req.Rules.Comments == "commit abc123"
+			})
+			client.On("UpdateRuleTree", AnyCTX, withNotes).Return(&papi.UpdateRulesResponse{}, nil).Once().Run(func(args mock.Arguments) {
+				State.Rules = args.Get(1).(papi.UpdateRulesRequest).Rules
+			})
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{{
+						Config: loadFixtureString("testdata/%s.tf", t.Name()),
+						Check: resource.ComposeAggregateTestCheckFunc(
+							resource.TestCheckResourceAttr("akamai_property.test", "version_notes", "commit abc123"),
+							resource.TestCheckResourceAttr("akamai_property.test", "rules", `{"rules":{"name":"default","options":{}}}`),
+						),
+						ExpectNonEmptyPlan: true,
+					}},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+
+		t.Run("error when rules fail validation at plan", func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+
+			setup := ComposeBehaviors(
+				PropertyLifecycle("test property", "prp_0", "grp_0"),
+				SetHostnames("prp_0", 1, "to.test.domain"),
+			)
+			setup(&TestState{Client: client})
+
+			isDryRun := mock.MatchedBy(func(req papi.UpdateRulesRequest) bool {
+				return req.DryRun && req.PropertyID == "prp_0" && req.PropertyVersion == 1 && req.Rules.Rules.Behaviors[0].Name == "origin"
+			})
+			client.On("UpdateRuleTree", AnyCTX, isDryRun).Return(&papi.UpdateRulesResponse{
+				PropertyID:      "prp_0",
+				PropertyVersion: 1,
+				Errors: []papi.RuleError{{
+					Title:        "Missing required behavior in default rule",
+					Detail:       "Behavior Content Provider Code needs to be present in the default section",
+					BehaviorName: "cpCode",
+				}},
+			}, nil).Once()
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{
+						{
+							Config:             loadFixtureString("testdata/%s/step0.tf", t.Name()),
+							Check:              CheckAttrs("prp_0", "to.test.domain", "1", "0", "0", "ehn_123"),
+							ExpectNonEmptyPlan: true,
+						},
+						{
+							Config:      loadFixtureString("testdata/%s/step1.tf", t.Name()),
+							ExpectError: regexp.MustCompile(`rules validation failed:\s+Missing required behavior in default rule`),
+						},
+					},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+
 		t.Run("error when deleting active property", func(t *testing.T) {
 			client := &mockpapi{}
 			client.Test(T{t})
@@ -710,6 +898,27 @@ func TestResProperty(t *testing.T) {
 			client.AssertExpectations(t)
 		})
 
+		t.Run("error when rule format is not available", func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+
+			client.On("GetRuleFormats", AnyCTX).Return(&papi.GetRuleFormatsResponse{
+				RuleFormats: papi.RuleFormatItems{Items: []string{"latest", "v2020-03-04", "v2020-11-02"}},
+			}, nil)
+
+			useClient(client, func() {
+				resource.UnitTest(t, resource.TestCase{
+					Providers: testAccProviders,
+					Steps: []resource.TestStep{{
+						Config:      loadFixtureString("testdata/TestResProperty/RuleFormat/unavailable.tf"),
+						ExpectError: regexp.MustCompile(`rule format not available: "v2015-08-08"`),
+					}},
+				})
+			})
+
+			client.AssertExpectations(t)
+		})
+
 		t.Run("error validations when updating property with rules tree", func(t *testing.T) {
 			client := &mockpapi{}
 			client.Test(T{t})
@@ -836,3 +1045,102 @@ func TestResProperty(t *testing.T) {
 		})
 	})
 }
+
+func TestCreateMissingEdgeHostnames(t *testing.T) {
+	property := papi.Property{ContractID: "ctr_0", GroupID: "grp_0", ProductID: "prd_0"}
+	hostname := func(cnameTo string, auto bool) map[string]interface{} {
+		return map[string]interface{}{
+			"cname_from":                "from.test.domain",
+			"cname_to":                  cnameTo,
+			"cert_provisioning_type":    "CPS_MANAGED",
+			"auto_create_edge_hostname": auto,
+			"ip_behavior":               papi.EHIPVersionV4,
+		}
+	}
+	existing := &papi.GetEdgeHostnamesResponse{EdgeHostnames: papi.EdgeHostnameItems{Items: []papi.EdgeHostnameGetItem{
+		{ID: "ehn_1", Domain: "exists.test.domain.edgesuite.net"},
+	}}}
+
+	tests := map[string]struct {
+		given     []interface{}
+		init      func(*mockpapi)
+		withError bool
+	}{
+		"nothing to create": {
+			given: []interface{}{hostname("missing.test.domain.edgesuite.net", false)},
+			init:  func(*mockpapi) {},
+		},
+		"existing edge hostname is reused": {
+			given: []interface{}{hostname("exists.test.domain.edgesuite.net", true)},
+			init: func(m *mockpapi) {
+				m.On("GetEdgeHostnames", AnyCTX, papi.GetEdgeHostnamesRequest{ContractID: "ctr_0", GroupID: "grp_0"}).Return(existing, nil).Once()
+			},
+		},
+		"missing edge hostname is created": {
+			given: []interface{}{hostname("missing.test.domain.akamaized.net", true)},
+			init: func(m *mockpapi) {
+				m.On("GetEdgeHostnames", AnyCTX, papi.GetEdgeHostnamesRequest{ContractID: "ctr_0", GroupID: "grp_0"}).Return(existing, nil).Once()
+				m.On("CreateEdgeHostname", AnyCTX, papi.CreateEdgeHostnameRequest{
+					ContractID: "ctr_0",
+					GroupID:    "grp_0",
+					EdgeHostname: papi.EdgeHostnameCreate{
+						ProductID:         "prd_0",
+						DomainPrefix:      "missing.test.domain",
+						DomainSuffix:      "akamaized.net",
+						SecureNetwork:     papi.EHSecureNetworkSharedCert,
+						IPVersionBehavior: papi.EHIPVersionV4,
+					},
+				}).Return(&papi.CreateEdgeHostnameResponse{EdgeHostnameID: "ehn_2"}, nil).Once()
+			},
+		},
+		"enhanced TLS edge hostname is not created": {
+			given: []interface{}{hostname("missing.test.domain.edgekey.net", true)},
+			init: func(m *mockpapi) {
+				m.On("GetEdgeHostnames", AnyCTX, papi.GetEdgeHostnamesRequest{ContractID: "ctr_0", GroupID: "grp_0"}).Return(existing, nil).Once()
+			},
+			withError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &mockpapi{}
+			client.Test(T{t})
+			test.init(client)
+
+			err := createMissingEdgeHostnames(context.Background(), client, property, test.given)
+			if test.withError {
+				assert.True(t, errors.Is(err, ErrEdgeHostnameAutoCreate), "want: %s; got: %s", ErrEdgeHostnameAutoCreate, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			client.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPropertyRulesNormalization(t *testing.T) {
+	rules := resourceProperty().Schema["rules"]
+
+	t.Run("rules are stored normalized", func(t *testing.T) {
+		given := `{"rules":{"name":"default","criteriaMustSatisfy":"all","behaviors":[{"options":{"ttl":"1d","behavior":"MAX_AGE"},"name":"caching"}]}}`
+		expected := `{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"1d"}}],"name":"default","options":{}}}`
+		assert.Equal(t, expected, rules.StateFunc(given))
+	})
+
+	t.Run("rules which can't be normalized are stored as given", func(t *testing.T) {
+		assert.Equal(t, `{"rules":[]}`, rules.StateFunc(`{"rules":[]}`))
+	})
+
+	t.Run("rules equal once normalized produce no diff", func(t *testing.T) {
+		old := `{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"1d"}}],"name":"default","options":{}}}`
+		new := `{"rules":{"name":"default","criteriaMustSatisfy":"all","comments":null,"behaviors":[{"name":"caching","options":{"ttl":"1d","behavior":"MAX_AGE"}}]}}`
+		assert.True(t, rules.DiffSuppressFunc("rules", old, new, nil))
+	})
+
+	t.Run("changed rules produce a diff", func(t *testing.T) {
+		old := `{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"1d"}}],"name":"default","options":{}}}`
+		new := `{"rules":{"name":"default","behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"2d"}}]}}`
+		assert.False(t, rules.DiffSuppressFunc("rules", old, new, nil))
+	})
+}
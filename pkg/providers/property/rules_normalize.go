@@ -0,0 +1,129 @@
+package property
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/v2/pkg/papi"
+)
+
+// NormalizeRules returns the canonical form of the given rule tree JSON, which is the form akamai_property keeps in
+// its rules attribute. Object keys are sorted, null values are removed, variables are ordered by name, empty options
+// are added where PAPI adds them and a criteriaMustSatisfy of "all", which PAPI assumes by default, is dropped. The
+// order of behaviors, criteria and children is kept as it is meaningful to PAPI. Members the rule tree format doesn't
+// define are kept as they are.
+func NormalizeRules(rulesJSON string) (string, error) {
+	var raw interface{}
+	if err := json.Unmarshal([]byte(rulesJSON), &raw); err != nil {
+		return "", fmt.Errorf("rules are not valid JSON: %w", err)
+	}
+
+	tree, ok := stripNulls(raw).(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("rules do not match the rule tree format: expected a JSON object")
+	}
+	rule, ok := tree["rules"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("rules do not match the rule tree format: expected a \"rules\" object")
+	}
+	if err := normalizeRule(rule, "rules"); err != nil {
+		return "", fmt.Errorf("rules do not match the rule tree format: %w", err)
+	}
+
+	// maps are marshaled with sorted keys
+	normalized, err := json.Marshal(tree)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
+
+// stripNulls removes the object members with a null value at any depth
+func stripNulls(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, member := range v {
+			if member == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = stripNulls(member)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = stripNulls(item)
+		}
+	}
+
+	return value
+}
+
+func normalizeRule(rule map[string]interface{}, path string) error {
+	if rule["criteriaMustSatisfy"] == string(papi.RuleCriteriaMustSatisfyAll) {
+		delete(rule, "criteriaMustSatisfy")
+	}
+	if _, ok := rule["options"]; !ok {
+		rule["options"] = map[string]interface{}{}
+	}
+
+	for _, key := range []string{"behaviors", "criteria"} {
+		features, err := ruleObjects(rule, key, path)
+		if err != nil {
+			return err
+		}
+		for _, feature := range features {
+			if _, ok := feature["options"]; !ok {
+				feature["options"] = map[string]interface{}{}
+			}
+		}
+	}
+
+	variables, err := ruleObjects(rule, "variables", path)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(variables, func(i, j int) bool {
+		return fmt.Sprint(variables[i]["name"]) < fmt.Sprint(variables[j]["name"])
+	})
+	for i, variable := range variables {
+		rule["variables"].([]interface{})[i] = variable
+	}
+
+	children, err := ruleObjects(rule, "children", path)
+	if err != nil {
+		return err
+	}
+	for i, child := range children {
+		if err := normalizeRule(child, fmt.Sprintf("%s.children[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ruleObjects returns the objects in the array member key of a rule, or an error if the member is not an array of
+// objects
+func ruleObjects(rule map[string]interface{}, key, path string) ([]map[string]interface{}, error) {
+	member, ok := rule[key]
+	if !ok {
+		return nil, nil
+	}
+	items, ok := member.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s.%s: expected an array", path, key)
+	}
+
+	objects := make([]map[string]interface{}, len(items))
+	for i, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.%s[%d]: expected an object", path, key, i)
+		}
+		objects[i] = object
+	}
+
+	return objects, nil
+}
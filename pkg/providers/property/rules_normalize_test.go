@@ -0,0 +1,68 @@
+package property
+
+import (
+	"testing"
+
+	"github.com/tj/assert"
+)
+
+func TestNormalizeRules(t *testing.T) {
+	tests := map[string]struct {
+		given     string
+		expected  string
+		withError bool
+	}{
+		"keys are sorted and nulls are removed": {
+			given:    `{"rules":{"name":"default","behaviors":[{"options":{"ttl":"1d","behavior":"MAX_AGE","tieredDistribution":null},"name":"caching"}],"comments":null}}`,
+			expected: `{"rules":{"behaviors":[{"name":"caching","options":{"behavior":"MAX_AGE","ttl":"1d"}}],"name":"default","options":{}}}`,
+		},
+		"keys of child rules are sorted": {
+			given:    `{"rules":{"name":"default","options":{"is_secure":true},"children":[{"options":{},"name":"child","criteriaMustSatisfy":"any"}]}}`,
+			expected: `{"rules":{"children":[{"criteriaMustSatisfy":"any","name":"child","options":{}}],"name":"default","options":{"is_secure":true}}}`,
+		},
+		"default criteriaMustSatisfy is dropped": {
+			given:    `{"rules":{"name":"default","criteriaMustSatisfy":"all","children":[{"name":"child","criteriaMustSatisfy":"all"}]}}`,
+			expected: `{"rules":{"children":[{"name":"child","options":{}}],"name":"default","options":{}}}`,
+		},
+		"behaviors and criteria without options get empty options": {
+			given:    `{"rules":{"name":"default","behaviors":[{"name":"origin"},{"name":"caching","options":null}],"criteria":[{"name":"path"}]}}`,
+			expected: `{"rules":{"behaviors":[{"name":"origin","options":{}},{"name":"caching","options":{}}],"criteria":[{"name":"path","options":{}}],"name":"default","options":{}}}`,
+		},
+		"variables are ordered by name": {
+			given:    `{"rules":{"name":"default","variables":[{"name":"PMUSER_B","value":"b","hidden":false,"sensitive":false},{"name":"PMUSER_C","value":"c","hidden":false,"sensitive":false},{"name":"PMUSER_A","value":"a","hidden":true,"sensitive":false}]}}`,
+			expected: `{"rules":{"name":"default","options":{},"variables":[{"hidden":true,"name":"PMUSER_A","sensitive":false,"value":"a"},{"hidden":false,"name":"PMUSER_B","sensitive":false,"value":"b"},{"hidden":false,"name":"PMUSER_C","sensitive":false,"value":"c"}]}}`,
+		},
+		"unknown members are kept": {
+			given:    `{"accountId":"act_1","rules":{"name":"default","customOverride":{"overrideId":"cbo_1","name":"override"},"behaviors":[{"name":"caching","templateUuid":"abc"}]}}`,
+			expected: `{"accountId":"act_1","rules":{"behaviors":[{"name":"caching","options":{},"templateUuid":"abc"}],"customOverride":{"name":"override","overrideId":"cbo_1"},"name":"default","options":{}}}`,
+		},
+		"invalid JSON": {
+			given:     `{"rules":`,
+			withError: true,
+		},
+		"not a rule tree": {
+			given:     `{"rules":[]}`,
+			withError: true,
+		},
+		"behaviors not an array": {
+			given:     `{"rules":{"name":"default","behaviors":{"name":"caching"}}}`,
+			withError: true,
+		},
+		"child rule not an object": {
+			given:     `{"rules":{"name":"default","children":["child"]}}`,
+			withError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			res, err := NormalizeRules(test.given)
+			if test.withError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, res)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_group" "akagroup" {
+  group_name_regex = "^web$"
+  parent_path = "example.com/Division B"
+  contract_id = "ctr_1234"
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rules" "rules" {
+  property_name = "property_name"
+  version = 2
+}
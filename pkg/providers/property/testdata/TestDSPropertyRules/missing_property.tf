@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rules" "rules" {
+  version = 2
+}
@@ -0,0 +1,17 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rules_normalize" "rules" {
+  rules = jsonencode({
+    rules = {
+      name                = "default"
+      criteriaMustSatisfy = "all"
+      comments            = null
+      behaviors = [{
+        name    = "caching"
+        options = { ttl = "1d", behavior = "MAX_AGE" }
+      }]
+    }
+  })
+}
@@ -0,0 +1,18 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rules_variables" "variables" {
+  variable {
+    name      = "PMUSER_ORIGIN"
+    value     = "origin.example.com"
+    hidden    = false
+    sensitive = false
+  }
+  variable {
+    name      = "PMUSER_ORIGIN"
+    value     = "other.example.com"
+    hidden    = false
+    sensitive = false
+  }
+}
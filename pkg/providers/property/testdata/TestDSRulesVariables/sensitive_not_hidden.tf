@@ -0,0 +1,12 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_rules_variables" "variables" {
+  variable {
+    name      = "PMUSER_SECRET"
+    value     = "value"
+    hidden    = false
+    sensitive = true
+  }
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_edge_hostnames" "ehns" {
+  group_id = "test"
+  contract_id = "ctr_test"
+}
@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_properties" "akaproperties" {
+  hostname   = "www.example.com"
+  name_regex = "^prpname[0-1]$"
+}
@@ -0,0 +1,9 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_properties" "akaproperties" {
+  group_id    = "grp_test"
+  contract_id = "ctr_test"
+  name_regex  = "^prpname[0-4]$"
+}
@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_activations" "activations" {
+  property_id = "test"
+  contract_id = "ctr_test"
+  group_id = "grp_test"
+  network = "PRODUCTION"
+}
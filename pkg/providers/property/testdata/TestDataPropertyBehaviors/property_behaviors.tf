@@ -0,0 +1,10 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+data "akamai_property_behaviors" "behaviors" {
+  group_id = "grp_test"
+  contract_id = "ctr_test"
+  property_id = "prp_test"
+  version = 2
+}
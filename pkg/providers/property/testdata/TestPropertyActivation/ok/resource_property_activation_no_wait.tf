@@ -0,0 +1,13 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_activation" "test" {
+  property_id = "test"
+  contact = ["user@example.com"]
+  version = 1
+  note = "staging loop"
+  wait_for_deployment = false
+  poll_interval = 10
+  deactivate_on_destroy = false
+}
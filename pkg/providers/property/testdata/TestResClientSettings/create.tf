@@ -0,0 +1,7 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_client_settings" "test" {
+  rule_format = "v2020-11-02"
+}
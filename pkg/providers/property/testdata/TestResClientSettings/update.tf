@@ -0,0 +1,8 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property_client_settings" "test" {
+  rule_format  = "latest"
+  use_prefixes = false
+}
@@ -0,0 +1,16 @@
+provider "akamai" {
+  edgerc = "~/.edgerc"
+}
+
+resource "akamai_property" "test" {
+  name        = "test property"
+  contract_id = "ctr_0"
+  group_id    = "grp_0"
+  product_id  = "prd_0"
+
+  clone_from {
+    property_id    = "prp_5"
+    version        = 3
+    copy_hostnames = true
+  }
+}